@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"context"
+	"firebase.google.com/go/db"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// game action types
+const (
+	ActionVote         = "vote"
+	ActionAbility      = "ability"
+	ActionDeath        = "death"
+	ActionStepAdvance  = "step_advance"
+	ActionCycleAdvance = "cycle_advance"
+)
+
+// GameAction is an entry of the append-only log under games/{id}/events
+type GameAction struct {
+	Seq       int64                  `json:"seq"`
+	Type      string                 `json:"type"`
+	GamerId   string                 `json:"gamer_id,omitempty"`
+	Target    string                 `json:"target,omitempty"`
+	StepBin   string                 `json:"step_bin,omitempty"`
+	Ability   string                 `json:"ability,omitempty"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+	TimeStamp string                 `json:"timestamp"`
+}
+
+// actionKey keeps the log ordered by key and prevents the rtdb from reading it back as an array
+func actionKey(seq int64) string {
+	return fmt.Sprintf("e%012d", seq)
+}
+
+// AppendGameAction reserves the next sequence number of the game's log and appends the action
+func (store *Store) AppendGameAction(gameId string, a *GameAction) error {
+
+	if a == nil || a.Type == "" {
+		return fmt.Errorf("invalid action object")
+	}
+
+	var seq int64
+	err := store.TransactionPath(context.Background(), "games/"+gameId+"/event_seq", func(t db.TransactionNode) (interface{}, error) {
+		var current int64
+		if err := t.Unmarshal(&current); err != nil {
+			return nil, err
+		}
+		seq = current + 1
+		return seq, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	a.Seq = seq
+	if a.TimeStamp == "" {
+		a.TimeStamp = strconv.FormatInt(time.Now().UnixMilli(), 10)
+	}
+	return store.SetPath(context.Background(), "games/"+gameId+"/events/"+actionKey(seq), a)
+}
+
+// logAction appends to the action log without failing the state change it records
+func (store *Store) logAction(gameId string, a *GameAction) {
+	if err := store.AppendGameAction(gameId, a); err != nil {
+		log.Printf("Error logging %s action for game %s: %v", a.Type, gameId, err)
+	}
+}
+
+// GetGameActions returns the actions of a game with a sequence number greater than afterSeq, in order
+func (store *Store) GetGameActions(gameId string, afterSeq int64) ([]*GameAction, error) {
+
+	var m map[string]*GameAction
+	q := store.NewRef("games/" + gameId + "/events").OrderByKey().StartAt(actionKey(afterSeq + 1))
+	if err := store.GetQuery(context.Background(), "games/"+gameId+"/events", q, &m); err != nil {
+		return nil, err
+	}
+
+	actions := make([]*GameAction, 0, len(m))
+	for _, a := range m {
+		if a != nil {
+			actions = append(actions, a)
+		}
+	}
+	sort.Slice(actions, func(i, j int) bool {
+		return actions[i].Seq < actions[j].Seq
+	})
+	return actions, nil
+}
@@ -0,0 +1,132 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"firebase.google.com/go/db"
+	"fmt"
+	"log"
+	"time"
+)
+
+// ErrAllocationTaken is returned when another game server already owns the game
+var ErrAllocationTaken = errors.New("game is allocated to another server")
+
+// Allocation records the game server owning a game, stored under allocations/{gameId}
+type Allocation struct {
+	GameId       string `json:"game_id"`
+	AllocationId string `json:"allocation_id"`
+	Address      string `json:"address"`
+	Port         int    `json:"port"`
+	ServerName   string `json:"server_name,omitempty"`
+	ClaimedAt    string `json:"claimed_at"`
+	Orphaned     bool   `json:"orphaned,omitempty"` // set when the game is orphaned, lets one other server take it over
+}
+
+// ClaimAllocation makes a server the owner of a game. The claim fails with ErrAllocationTaken while
+// another allocation owns the game, unless the game was orphaned by its server going silent. The
+// orphaned mark is checked and cleared in the claim's transaction, so only one server takes over.
+// Claiming again with the same allocation id refreshes the address.
+func (store *Store) ClaimAllocation(gameId string, a *Allocation) error {
+
+	if a == nil || a.AllocationId == "" || a.Address == "" {
+		return fmt.Errorf("invalid allocation object")
+	}
+	ctx := context.Background()
+	var status string
+	if err := store.GetPath(ctx, "games/"+gameId+"/status", &status); err != nil {
+		return err
+	}
+	if status == "" {
+		return fmt.Errorf("game not found: %s", gameId)
+	}
+
+	a.GameId = gameId
+	a.ClaimedAt = FormatTime(time.Now())
+	err := store.TransactionPath(ctx, "allocations/"+gameId, func(t db.TransactionNode) (interface{}, error) {
+		current := &Allocation{}
+		if err := t.Unmarshal(current); err != nil {
+			return nil, err
+		}
+		if current.AllocationId != "" && current.AllocationId != a.AllocationId && !current.Orphaned {
+			return nil, ErrAllocationTaken
+		}
+		a.Orphaned = false
+		return a, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// keep the game's server info in step so clients connect to the new owner
+	err = store.UpdatePath(ctx, "games/"+gameId+"/info", map[string]interface{}{
+		"name":    a.ServerName,
+		"address": a.Address,
+		"port":    a.Port,
+	})
+	if err != nil {
+		log.Printf("Error updating server info of game %s: %v", gameId, err)
+	}
+	return nil
+}
+
+// LookupAllocation returns the server a client connects to for a game, nil when it has none
+func (store *Store) LookupAllocation(gameId string) (*Allocation, error) {
+
+	var a *Allocation
+	if err := store.GetPath(context.Background(), "allocations/"+gameId, &a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// ReleaseAllocation gives up a game, only its owning allocation can release it
+func (store *Store) ReleaseAllocation(gameId string, allocationId string) error {
+
+	return store.TransactionPath(context.Background(), "allocations/"+gameId, func(t db.TransactionNode) (interface{}, error) {
+		current := &Allocation{}
+		if err := t.Unmarshal(current); err != nil {
+			return nil, err
+		}
+		if current.AllocationId != "" && current.AllocationId != allocationId {
+			return nil, ErrAllocationTaken
+		}
+		return nil, nil
+	})
+}
+
+// markAllocationOrphaned opens an orphaned game's allocation to a takeover
+func (store *Store) markAllocationOrphaned(gameId string) error {
+
+	return store.TransactionPath(context.Background(), "allocations/"+gameId, func(t db.TransactionNode) (interface{}, error) {
+		var current *Allocation
+		if err := t.Unmarshal(&current); err != nil {
+			return nil, err
+		}
+		if current == nil || current.AllocationId == "" {
+			return nil, nil
+		}
+		current.Orphaned = true
+		return current, nil
+	})
+}
+
+// checkAllocationOwner fails unless allocationId owns the game, clearing the orphaned mark of an
+// owner that came back before anyone took over
+func (store *Store) checkAllocationOwner(gameId string, allocationId string) error {
+
+	return store.TransactionPath(context.Background(), "allocations/"+gameId, func(t db.TransactionNode) (interface{}, error) {
+		current := &Allocation{}
+		if err := t.Unmarshal(current); err != nil {
+			return nil, err
+		}
+		if current.AllocationId == "" {
+			return nil, fmt.Errorf("game %s has no allocation", gameId)
+		}
+		if current.AllocationId != allocationId {
+			return nil, ErrAllocationTaken
+		}
+		current.Orphaned = false
+		return current, nil
+	})
+}
@@ -0,0 +1,186 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"log"
+	"math/rand"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// ErrEventQuotaExceeded is returned when a player tracked more events of a type than its quota allows
+var ErrEventQuotaExceeded = errors.New("analytics event quota exceeded")
+
+// eventNamePattern keeps event names usable as table and metric names
+var eventNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// maxEventProperties caps the properties of one event so events stay lightweight
+const maxEventProperties = 25
+
+// AnalyticsEvent is a client analytics event, stored under analytics_events/{yyyy-mm-dd}/{bin}
+// by the default sink
+type AnalyticsEvent struct {
+	Bin        string                 `json:"bin"`
+	PlayerId   string                 `json:"player_id"`
+	Event      string                 `json:"event"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+	SampleRate float64                `json:"sample_rate,omitempty"` // weight the event by 1/SampleRate
+	TimeStamp  int64                  `json:"timestamp"`             // unix milliseconds
+}
+
+// AnalyticsSink receives batches of tracked events, e.g. the pubsub package's AnalyticsSink
+type AnalyticsSink interface {
+	WriteEvents(ctx context.Context, events []*AnalyticsEvent) error
+}
+
+// AnalyticsOptions tunes event ingestion, zero values take the defaults
+type AnalyticsOptions struct {
+	BatchSize     int                // events written together, default 100
+	FlushInterval time.Duration      // longest wait before a partial batch is written, default 5s
+	SampleRates   map[string]float64 // share of an event type kept, 0 to 1, types not listed keep all
+	Quotas        map[string]int     // events of a type a player may track per QuotaWindow, unlisted types are unlimited
+	QuotaWindow   time.Duration      // default one hour
+}
+
+type analytics struct {
+	opts  AnalyticsOptions
+	sink  AnalyticsSink // nil writes to the database
+	mu    sync.Mutex
+	batch []*AnalyticsEvent
+	timer *time.Timer
+	rng   *rand.Rand
+
+	windowStart time.Time
+	counts      map[string]int // by player and event type within the window
+}
+
+func newAnalytics(o AnalyticsOptions, sink AnalyticsSink) *analytics {
+	if o.BatchSize <= 0 {
+		o.BatchSize = 100
+	}
+	if o.FlushInterval <= 0 {
+		o.FlushInterval = 5 * time.Second
+	}
+	if o.QuotaWindow <= 0 {
+		o.QuotaWindow = time.Hour
+	}
+	return &analytics{
+		opts:   o,
+		sink:   sink,
+		rng:    rand.New(rand.NewSource(time.Now().UnixNano())),
+		counts: make(map[string]int),
+	}
+}
+
+// WithAnalytics configures TrackEvent, forwarding batches to sink instead of the database when set
+func WithAnalytics(o AnalyticsOptions, sink AnalyticsSink) StoreOption {
+	return func(store *Store) {
+		store.analytics = newAnalytics(o, sink)
+	}
+}
+
+// TrackEvent queues an analytics event of a player for the next batch. Events dropped by sampling
+// return nil, events over the type's quota ErrEventQuotaExceeded.
+func (store *Store) TrackEvent(playerId string, event string, properties map[string]interface{}) error {
+
+	if playerId == "" || !eventNamePattern.MatchString(event) {
+		return fmt.Errorf("invalid analytics event %q", event)
+	}
+	if len(properties) > maxEventProperties {
+		return fmt.Errorf("analytics event %s has %d properties, at most %d are allowed", event, len(properties), maxEventProperties)
+	}
+
+	a := store.analytics
+	now := time.Now()
+
+	a.mu.Lock()
+	rate, sampled := a.opts.SampleRates[event]
+	if sampled && a.rng.Float64() >= rate {
+		a.mu.Unlock()
+		return nil
+	}
+	if limit, ok := a.opts.Quotas[event]; ok {
+		if now.Sub(a.windowStart) >= a.opts.QuotaWindow {
+			a.windowStart = now
+			a.counts = make(map[string]int)
+		}
+		key := playerId + "/" + event
+		if a.counts[key] >= limit {
+			a.mu.Unlock()
+			return ErrEventQuotaExceeded
+		}
+		a.counts[key]++
+	}
+
+	e := &AnalyticsEvent{
+		Bin:        uuid.New().String(),
+		PlayerId:   playerId,
+		Event:      event,
+		Properties: properties,
+		TimeStamp:  now.UnixMilli(),
+	}
+	if sampled {
+		e.SampleRate = rate
+	}
+	a.batch = append(a.batch, e)
+	var full []*AnalyticsEvent
+	if len(a.batch) >= a.opts.BatchSize {
+		full = a.take()
+	} else if a.timer == nil {
+		a.timer = time.AfterFunc(a.opts.FlushInterval, store.FlushAnalytics)
+	}
+	a.mu.Unlock()
+
+	if full != nil {
+		store.writeAnalytics(full)
+	}
+	return nil
+}
+
+// take empties the batch, the caller holds the lock
+func (a *analytics) take() []*AnalyticsEvent {
+	batch := a.batch
+	a.batch = nil
+	if a.timer != nil {
+		a.timer.Stop()
+		a.timer = nil
+	}
+	return batch
+}
+
+// FlushAnalytics writes the queued events now, e.g. before shutting down
+func (store *Store) FlushAnalytics() {
+
+	a := store.analytics
+	a.mu.Lock()
+	batch := a.take()
+	a.mu.Unlock()
+	if len(batch) > 0 {
+		store.writeAnalytics(batch)
+	}
+}
+
+// writeAnalytics hands a batch to the sink, or writes it to the database in one update
+func (store *Store) writeAnalytics(batch []*AnalyticsEvent) {
+
+	ctx := context.Background()
+	if sink := store.analytics.sink; sink != nil {
+		if err := sink.WriteEvents(ctx, batch); err != nil {
+			log.Printf("Error forwarding %d analytics events: %v", len(batch), err)
+		}
+		return
+	}
+
+	m := make(map[string]interface{}, len(batch))
+	for _, e := range batch {
+		day := time.UnixMilli(e.TimeStamp).UTC().Format("2006-01-02")
+		m[day+"/"+e.Bin] = e
+	}
+	if err := store.UpdatePath(ctx, "analytics_events", m); err != nil {
+		log.Printf("Error writing %d analytics events: %v", len(batch), err)
+	}
+}
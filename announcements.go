@@ -0,0 +1,183 @@
+package v1
+
+import (
+	"context"
+	"firebase.google.com/go/db"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// maxAnnouncementLength bounds the text of an announcement
+const maxAnnouncementLength = 2000
+
+// Announcement is a post on a group's board, stored under game_groups/{id}/announcements/{bin}
+type Announcement struct {
+	Bin       string              `json:"bin"`
+	AuthorId  string              `json:"author_id"`
+	Text      string              `json:"text"`
+	Pinned    bool                `json:"pinned"`
+	CreatedAt string              `json:"created_at"`
+	EditedAt  string              `json:"edited_at,omitempty"`
+	History   []*AnnouncementEdit `json:"history,omitempty"` // previous versions, oldest first
+}
+
+// AnnouncementEdit is a replaced version of an announcement
+type AnnouncementEdit struct {
+	Text     string `json:"text"`
+	EditorId string `json:"editor_id"`
+	EditedAt string `json:"edited_at"`
+}
+
+func checkAnnouncementText(text string) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" || len(text) > maxAnnouncementLength {
+		return "", fmt.Errorf("announcement must be 1 to %d characters", maxAnnouncementLength)
+	}
+	return text, nil
+}
+
+// PostGroupAnnouncement posts to a group's board, any member can post
+func (store *Store) PostGroupAnnouncement(groupId string, authorId string, text string) (*Announcement, error) {
+
+	text, err := checkAnnouncementText(text)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := store.requireRole(groupId, authorId, RoleMember); err != nil {
+		return nil, err
+	}
+
+	a := &Announcement{
+		AuthorId:  authorId,
+		Text:      text,
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	// push keys sort chronologically, which the paged reads rely on
+	ref, err := store.PushPath(context.Background(), "game_groups/"+groupId+"/announcements", a)
+	if err != nil {
+		return nil, err
+	}
+	a.Bin = ref.Key
+	if err := store.SetPath(context.Background(), "game_groups/"+groupId+"/announcements/"+a.Bin+"/bin", a.Bin); err != nil {
+		return nil, err
+	}
+	store.touchGroupActivity(groupId)
+	return a, nil
+}
+
+// EditGroupAnnouncement replaces the text of an announcement, keeping the old one in its history.
+// Authors can edit their own posts, admins any post.
+func (store *Store) EditGroupAnnouncement(groupId string, editorId string, bin string, text string) error {
+
+	text, err := checkAnnouncementText(text)
+	if err != nil {
+		return err
+	}
+	role, err := store.requireRole(groupId, editorId, RoleMember)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	return store.TransactionPath(context.Background(), "game_groups/"+groupId+"/announcements/"+bin, func(t db.TransactionNode) (interface{}, error) {
+		a := &Announcement{}
+		if err := t.Unmarshal(a); err != nil {
+			return nil, err
+		}
+		if a.Bin == "" {
+			return nil, fmt.Errorf("announcement not found: %s", bin)
+		}
+		if a.AuthorId != editorId && roleRank[role] < roleRank[RoleAdmin] {
+			return nil, fmt.Errorf("player %s can't edit announcement %s", editorId, bin)
+		}
+		a.History = append(a.History, &AnnouncementEdit{Text: a.Text, EditorId: editorId, EditedAt: now})
+		a.Text = text
+		a.EditedAt = now
+		return a, nil
+	})
+}
+
+// PinGroupAnnouncement pins or unpins an announcement, admins only
+func (store *Store) PinGroupAnnouncement(groupId string, actorId string, bin string, pinned bool) error {
+
+	if _, err := store.requireRole(groupId, actorId, RoleAdmin); err != nil {
+		return err
+	}
+	return store.SetPath(context.Background(), "game_groups/"+groupId+"/announcements/"+bin+"/pinned", pinned)
+}
+
+// DeleteGroupAnnouncement removes an announcement, by its author or an admin
+func (store *Store) DeleteGroupAnnouncement(groupId string, actorId string, bin string) error {
+
+	role, err := store.requireRole(groupId, actorId, RoleMember)
+	if err != nil {
+		return err
+	}
+	if roleRank[role] < roleRank[RoleAdmin] {
+		var author string
+		if err := store.GetPath(context.Background(), "game_groups/"+groupId+"/announcements/"+bin+"/author_id", &author); err != nil {
+			return err
+		}
+		if author != actorId {
+			return fmt.Errorf("player %s can't delete announcement %s", actorId, bin)
+		}
+	}
+	return store.DeletePath(context.Background(), "game_groups/"+groupId+"/announcements/"+bin)
+}
+
+// GetGroupAnnouncements returns a page of announcements newest first, starting before the given
+// bin (empty for the newest), and the bin to pass for the next page
+func (store *Store) GetGroupAnnouncements(groupId string, before string, limit int) ([]*Announcement, string, error) {
+
+	if limit <= 0 {
+		limit = 20
+	}
+	q := store.NewRef("game_groups/" + groupId + "/announcements").OrderByKey()
+	if before != "" {
+		q = q.EndAt(before)
+	}
+	// one extra to skip the cursor itself and one to know whether there is a next page
+	nodes, err := store.GetOrderedQuery(context.Background(), "game_groups/"+groupId+"/announcements", q.LimitToLast(limit+2))
+	if err != nil {
+		return nil, "", err
+	}
+
+	var page []*Announcement
+	for i := len(nodes) - 1; i >= 0; i-- {
+		if nodes[i].Key() == before {
+			continue
+		}
+		a := &Announcement{}
+		if err := nodes[i].Unmarshal(a); err != nil {
+			return nil, "", err
+		}
+		page = append(page, a)
+	}
+
+	next := ""
+	if len(page) > limit {
+		page = page[:limit]
+		next = page[limit-1].Bin
+	}
+	return page, next, nil
+}
+
+// GetPinnedAnnouncements returns a group's pinned announcements oldest first
+func (store *Store) GetPinnedAnnouncements(groupId string) ([]*Announcement, error) {
+
+	q := store.NewRef("game_groups/" + groupId + "/announcements").OrderByChild("pinned").EqualTo(true)
+	nodes, err := store.GetOrderedQuery(context.Background(), "game_groups/"+groupId+"/announcements", q)
+	if err != nil {
+		return nil, err
+	}
+	var pinned []*Announcement
+	for _, n := range nodes {
+		a := &Announcement{}
+		if err := n.Unmarshal(a); err != nil {
+			return nil, err
+		}
+		pinned = append(pinned, a)
+	}
+	return pinned, nil
+}
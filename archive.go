@@ -0,0 +1,185 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+	"sort"
+	"time"
+)
+
+// ArchivedGame is the frozen copy of a finished game kept under archive/games
+type ArchivedGame struct {
+	Bin        string                      `json:"bin"`
+	ArchivedAt string                      `json:"archived_at"`
+	Game       *models.Game                `json:"game"`
+	Results    map[string][]*models.Result `json:"results,omitempty"` // map of step bin and the results recorded in that step, every cycle included
+
+	// CycleResults is the game's cycle_results node, the results of every cycle keyed by cycle, step and gamer
+	CycleResults map[string]map[string]map[string][]*models.Result `json:"cycle_results,omitempty"`
+
+	// CompressedResults holds Results and the steps' result nodes as base64 gzipped JSON in
+	// archives written with WithResultCompression, reads decompress it
+	CompressedResults string `json:"compressed_results,omitempty"`
+}
+
+// MatchSummary is the per player entry kept under match_history/{playerId}
+type MatchSummary struct {
+	GameBin     string `json:"game_bin"`
+	GroupId     string `json:"group_id,omitempty"`
+	CharacterId string `json:"character_id,omitempty"`
+	Status      string `json:"status"`
+	StartTime   string `json:"start_time,omitempty"`
+	EndTime     string `json:"end_time,omitempty"`
+	Survived    bool   `json:"survived"`
+	Won         bool   `json:"won"`
+	Cycles      int    `json:"cycles,omitempty"`
+	ArchivedAt  string `json:"archived_at"`
+	Cold        bool   `json:"cold,omitempty"` // the game was moved to cold storage
+}
+
+// ArchiveGame snapshots the final state of a game, its step results and the results of every
+// earlier cycle into the archive tree and writes a match summary for every gamer that took part.
+func (store *Store) ArchiveGame(gameId string) (*ArchivedGame, error) {
+
+	game, err := store.getGameByBin(gameId)
+	if err != nil {
+		return nil, err
+	}
+	if game == nil {
+		return nil, fmt.Errorf("game not found: %s", gameId)
+	}
+	// the steps only hold the current cycle, earlier ones are kept under cycle_results
+	cycles, err := store.getAllCycleResults(context.Background(), gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	stamp := time.Now().UTC().Format(time.RFC3339)
+	archived := &ArchivedGame{
+		Bin:          game.Bin,
+		ArchivedAt:   stamp,
+		Game:         game,
+		Results:      make(map[string][]*models.Result),
+		CycleResults: cycles,
+	}
+
+	// collect the results of every step and cycle in step order
+	seen := make(map[string]bool)
+	add := func(stepBin string, results []*models.Result) {
+		for _, r := range results {
+			if r == nil || (r.Bin != "" && seen[r.Bin]) {
+				continue
+			}
+			seen[r.Bin] = r.Bin != ""
+			archived.Results[stepBin] = append(archived.Results[stepBin], r)
+		}
+	}
+	for stepBin, step := range game.Steps {
+		if step == nil {
+			continue
+		}
+		for _, results := range step.Result {
+			add(stepBin, results)
+		}
+	}
+	for stepBin, results := range flattenCycleResults(cycles) {
+		add(stepBin, results)
+	}
+	for stepBin := range archived.Results {
+		sortResults(archived.Results[stepBin])
+	}
+
+	winners := make(map[string]bool)
+	for _, w := range game.Winners {
+		if w != nil {
+			winners[w.Bin] = true
+		}
+	}
+
+	stored, err := store.storedArchive(archived)
+	if err != nil {
+		return nil, err
+	}
+
+	// write the archive and every summary in a single multi-path update
+	m := map[string]interface{}{
+		"archive/games/" + game.Bin: stored,
+	}
+	for gamerId, gamer := range game.Gamers {
+		if gamer == nil {
+			continue
+		}
+		summary := &MatchSummary{
+			GameBin:     game.Bin,
+			GroupId:     game.GroupId,
+			CharacterId: gamer.CharacterId,
+			Status:      game.Status,
+			Survived:    gamer.IsAlive,
+			Won:         winners[gamerId],
+			Cycles:      game.NightCycles,
+			ArchivedAt:  stamp,
+		}
+		if game.Sync != nil {
+			summary.StartTime = game.Sync.StartTime
+			summary.EndTime = game.Sync.EndTime
+		}
+		m["match_history/"+gamerId+"/"+game.Bin] = summary
+	}
+
+	if err := store.UpdatePath(context.Background(), "/", m); err != nil {
+		return nil, err
+	}
+	return archived, nil
+}
+
+// flattenCycleResults returns the results of every cycle keyed by step
+func flattenCycleResults(cycles map[string]map[string]map[string][]*models.Result) map[string][]*models.Result {
+
+	m := make(map[string][]*models.Result)
+	for _, steps := range cycles {
+		for stepBin, byGamer := range steps {
+			for _, results := range byGamer {
+				m[stepBin] = append(m[stepBin], results...)
+			}
+		}
+	}
+	return m
+}
+
+// GetMatchHistory returns the summaries of every archived game the player took part in, newest
+// first, including games moved to cold storage
+func (store *Store) GetMatchHistory(playerId string) ([]*MatchSummary, error) {
+
+	var m map[string]*MatchSummary
+	if err := store.GetPath(context.Background(), "match_history/"+playerId, &m); err != nil {
+		return nil, err
+	}
+
+	history := make([]*MatchSummary, 0, len(m))
+	for _, s := range m {
+		if s != nil {
+			history = append(history, s)
+		}
+	}
+	sort.Slice(history, func(i, j int) bool {
+		return history[i].ArchivedAt > history[j].ArchivedAt
+	})
+	return history, nil
+}
+
+// GetArchivedGame returns the full archived replay of a game, from cold storage once it was moved there
+func (store *Store) GetArchivedGame(gameId string) (*ArchivedGame, error) {
+
+	var a *ArchivedGame
+	if err := store.GetPath(context.Background(), "archive/games/"+gameId, &a); err != nil {
+		return nil, err
+	}
+	if a == nil {
+		return store.getColdGame(gameId)
+	}
+	if err := a.decompress(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
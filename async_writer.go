@@ -0,0 +1,145 @@
+package v1
+
+import (
+	"context"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AsyncWriter buffers writes that must not block the caller, e.g. heartbeats and chat, and
+// flushes them in batched multi-path updates on an interval or on Flush
+type AsyncWriter struct {
+	store      *Store
+	mu         sync.Mutex
+	flushMu    sync.Mutex // keeps batches in enqueue order
+	pending    []bulkWrite
+	maxPending int
+	stop       chan struct{}
+	stopped    sync.WaitGroup
+
+	// OnOverflow is called with a write dropped because the buffer is full
+	OnOverflow func(path string, v interface{})
+	// OnError is called with a batch that failed to flush in the background
+	OnError func(err error, batch map[string]interface{})
+}
+
+// defaultAsyncInterval is the flush interval of a writer created without a positive one
+const defaultAsyncInterval = time.Second
+
+// NewAsyncWriter returns a writer flushing every interval, one second when it isn't positive, and
+// holding at most maxPending writes
+func NewAsyncWriter(store *Store, interval time.Duration, maxPending int) *AsyncWriter {
+
+	if interval <= 0 {
+		interval = defaultAsyncInterval
+	}
+	w := &AsyncWriter{
+		store:      store,
+		maxPending: maxPending,
+		stop:       make(chan struct{}),
+	}
+	w.stopped.Add(1)
+	go w.run(interval)
+	return w
+}
+
+func (w *AsyncWriter) run(interval time.Duration) {
+
+	defer w.stopped.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := w.Flush(context.Background()); err != nil {
+				log.Printf("Error flushing async writes: %v", err)
+			}
+		case <-w.stop:
+			return
+		}
+	}
+}
+
+// Enqueue buffers setting path to v, nil deletes it. It reports false when the write was dropped
+// because the buffer is full.
+func (w *AsyncWriter) Enqueue(path string, v interface{}) bool {
+
+	w.mu.Lock()
+	if w.maxPending > 0 && len(w.pending) >= w.maxPending {
+		w.mu.Unlock()
+		if w.OnOverflow != nil {
+			w.OnOverflow(path, v)
+		}
+		return false
+	}
+	w.pending = append(w.pending, bulkWrite{path: strings.Trim(path, "/"), v: v})
+	w.mu.Unlock()
+	return true
+}
+
+// Pending returns the number of buffered writes
+func (w *AsyncWriter) Pending() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.pending)
+}
+
+// Flush writes everything buffered so far. Failed batches are reported to OnError and dropped.
+func (w *AsyncWriter) Flush(ctx context.Context) error {
+
+	w.flushMu.Lock()
+	defer w.flushMu.Unlock()
+
+	w.mu.Lock()
+	writes := w.pending
+	w.pending = nil
+	w.mu.Unlock()
+
+	var first error
+	for _, batch := range batchWrites(writes) {
+		if err := w.store.UpdatePath(ctx, "/", batch); err != nil {
+			if w.OnError != nil {
+				w.OnError(err, batch)
+			}
+			if first == nil {
+				first = err
+			}
+		}
+	}
+	return first
+}
+
+// Close stops the background flushing and flushes what is left
+func (w *AsyncWriter) Close(ctx context.Context) error {
+	close(w.stop)
+	w.stopped.Wait()
+	return w.Flush(ctx)
+}
+
+// batchWrites groups writes into multi-path updates, starting a new one whenever a path is an
+// ancestor or descendant of one already in the batch since the database rejects those. A
+// repeated path replaces the earlier value.
+func batchWrites(writes []bulkWrite) []map[string]interface{} {
+
+	var batches []map[string]interface{}
+	var batch map[string]interface{}
+	for _, w := range writes {
+		if batch == nil || overlaps(batch, w.path) {
+			batch = make(map[string]interface{})
+			batches = append(batches, batch)
+		}
+		batch[w.path] = w.v
+	}
+	return batches
+}
+
+func overlaps(batch map[string]interface{}, path string) bool {
+	for k := range batch {
+		if k != path && (strings.HasPrefix(path, k+"/") || strings.HasPrefix(k, path+"/")) {
+			return true
+		}
+	}
+	return false
+}
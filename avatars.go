@@ -0,0 +1,104 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"io"
+	"log"
+)
+
+// MaxAvatarSize is the largest avatar image UploadAvatar accepts
+const MaxAvatarSize = 5 << 20
+
+// avatar upload errors
+var (
+	ErrAvatarTooLarge    = errors.New("avatar is too large")
+	ErrUnsupportedAvatar = errors.New("unsupported avatar content type")
+)
+
+// avatar content types and the extension their objects get
+var avatarTypes = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/webp": ".webp",
+	"image/gif":  ".gif",
+}
+
+// WithAvatarBucket sets the Cloud Storage bucket avatars are uploaded to. The bucket must allow
+// public reads, players' photo urls point straight at their objects.
+func WithAvatarBucket(bucket string) StoreOption {
+	return func(store *Store) {
+		store.avatarBucket = bucket
+	}
+}
+
+// UploadAvatar stores an avatar image under avatars/{playerId}/ in the avatar bucket, points the
+// player's photo at it and deletes the object of the previous avatar
+func (store *Store) UploadAvatar(playerId string, r io.Reader, contentType string) (string, error) {
+
+	if store.app == nil {
+		return "", fmt.Errorf("store is not connected")
+	}
+	if store.avatarBucket == "" {
+		return "", fmt.Errorf("no avatar bucket is configured")
+	}
+	ext, ok := avatarTypes[contentType]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedAvatar, contentType)
+	}
+
+	ctx := context.Background()
+	var previous string
+	if err := store.GetPath(ctx, "players/"+playerId+"/photo_object", &previous); err != nil {
+		return "", err
+	}
+
+	client, err := store.app.Storage(ctx)
+	if err != nil {
+		return "", err
+	}
+	bucket, err := client.Bucket(store.avatarBucket)
+	if err != nil {
+		return "", err
+	}
+
+	name := "avatars/" + playerId + "/" + uuid.New().String() + ext
+	obj := bucket.Object(name)
+	// cancelling the upload's context aborts it, closing the writer would commit a partial object
+	uploadCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	w := obj.NewWriter(uploadCtx)
+	w.ContentType = contentType
+	w.CacheControl = "public, max-age=86400"
+	// read one byte past the limit to tell a full sized image from a larger one
+	n, err := io.Copy(w, io.LimitReader(r, MaxAvatarSize+1))
+	if err == nil && n > MaxAvatarSize {
+		err = ErrAvatarTooLarge
+	}
+	if err != nil {
+		cancel()
+		_ = w.Close()
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", fmt.Errorf("error uploading avatar of %s: %v", playerId, err)
+	}
+
+	url := "https://storage.googleapis.com/" + store.avatarBucket + "/" + name
+	if err := store.UpdatePlayerProfile(playerId, ProfilePatch{Photo: &url}); err != nil {
+		_ = obj.Delete(ctx)
+		return "", err
+	}
+	if err := store.SetPath(ctx, "players/"+playerId+"/photo_object", name); err != nil {
+		return "", err
+	}
+
+	if previous != "" && previous != name {
+		if err := bucket.Object(previous).Delete(ctx); err != nil {
+			log.Printf("Error deleting previous avatar %s: %v", previous, err)
+		}
+	}
+	return url, nil
+}
@@ -0,0 +1,186 @@
+// Package backup snapshots database subtrees to Google Cloud Storage and restores them.
+package backup
+
+import (
+	"bytes"
+	"cloud.google.com/go/storage"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	v1 "github.com/horcu/pm-store"
+	"io"
+	"log"
+	"strings"
+	"time"
+)
+
+// Config selects what is backed up, where to and how often
+type Config struct {
+	Bucket   string
+	Prefix   string        // object prefix, e.g. "backups/prod"
+	Paths    []string      // subtrees to snapshot, e.g. players, game_groups, characters
+	Interval time.Duration // time between snapshots when running with Run
+}
+
+// Manifest describes one snapshot, stored next to its objects as manifest.json
+type Manifest struct {
+	SnapshotId string            `json:"snapshot_id"`
+	CreatedAt  string            `json:"created_at"`
+	Entries    map[string]*Entry `json:"entries"` // map of database path and its object
+}
+
+// Entry is a single backed up subtree
+type Entry struct {
+	Path   string `json:"path"`
+	Object string `json:"object"`
+	SHA256 string `json:"sha256"`
+	Size   int64  `json:"size"`
+}
+
+// Backup writes snapshots of a store to a bucket
+type Backup struct {
+	store  *v1.Store
+	client *storage.Client
+	cfg    Config
+}
+
+// New returns a Backup for the store using the given storage client
+func New(store *v1.Store, client *storage.Client, cfg Config) *Backup {
+	return &Backup{store: store, client: client, cfg: cfg}
+}
+
+func (b *Backup) object(snapshotId string, name string) string {
+	parts := []string{strings.Trim(b.cfg.Prefix, "/"), snapshotId, name}
+	if parts[0] == "" {
+		parts = parts[1:]
+	}
+	return strings.Join(parts, "/")
+}
+
+func objectName(path string) string {
+	return strings.ReplaceAll(strings.Trim(path, "/"), "/", "__") + ".json"
+}
+
+// Run takes a snapshot every interval until ctx is cancelled
+func (b *Backup) Run(ctx context.Context) error {
+
+	if b.cfg.Interval <= 0 {
+		return fmt.Errorf("invalid backup interval: %v", b.cfg.Interval)
+	}
+	ticker := time.NewTicker(b.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			m, err := b.Snapshot(ctx)
+			if err != nil {
+				log.Printf("Error taking backup: %v", err)
+				continue
+			}
+			log.Printf("backup %s written", m.SnapshotId)
+		}
+	}
+}
+
+// Snapshot exports every configured path to the bucket and writes the manifest last,
+// so a snapshot without a manifest is incomplete and never restored.
+func (b *Backup) Snapshot(ctx context.Context) (*Manifest, error) {
+
+	now := time.Now().UTC()
+	m := &Manifest{
+		SnapshotId: now.Format("20060102T150405Z"),
+		CreatedAt:  now.Format(time.RFC3339),
+		Entries:    make(map[string]*Entry),
+	}
+
+	bucket := b.client.Bucket(b.cfg.Bucket)
+	for _, path := range b.cfg.Paths {
+		var buf bytes.Buffer
+		if err := b.store.ExportTree(path, &buf); err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(buf.Bytes())
+
+		e := &Entry{
+			Path:   path,
+			Object: b.object(m.SnapshotId, objectName(path)),
+			SHA256: hex.EncodeToString(sum[:]),
+			Size:   int64(buf.Len()),
+		}
+		if err := write(ctx, bucket.Object(e.Object), "application/json", buf.Bytes()); err != nil {
+			return nil, fmt.Errorf("error writing backup of %s: %v", path, err)
+		}
+		m.Entries[path] = e
+	}
+
+	b2, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	if err := write(ctx, bucket.Object(b.object(m.SnapshotId, "manifest.json")), "application/json", b2); err != nil {
+		return nil, fmt.Errorf("error writing backup manifest: %v", err)
+	}
+	return m, nil
+}
+
+// GetManifest reads the manifest of a snapshot
+func (b *Backup) GetManifest(ctx context.Context, snapshotId string) (*Manifest, error) {
+
+	data, err := read(ctx, b.client.Bucket(b.cfg.Bucket).Object(b.object(snapshotId, "manifest.json")))
+	if err != nil {
+		return nil, fmt.Errorf("error reading backup manifest %s: %v", snapshotId, err)
+	}
+	m := &Manifest{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Restore verifies the checksum of a backed up path and writes it back to the database
+func (b *Backup) Restore(ctx context.Context, snapshotId string, path string) error {
+
+	m, err := b.GetManifest(ctx, snapshotId)
+	if err != nil {
+		return err
+	}
+	e, ok := m.Entries[path]
+	if !ok {
+		return fmt.Errorf("path %s is not part of backup %s", path, snapshotId)
+	}
+
+	data, err := read(ctx, b.client.Bucket(b.cfg.Bucket).Object(e.Object))
+	if err != nil {
+		return fmt.Errorf("error reading backup of %s: %v", path, err)
+	}
+	sum := sha256.Sum256(data)
+	if hex.EncodeToString(sum[:]) != e.SHA256 || int64(len(data)) != e.Size {
+		return fmt.Errorf("backup of %s in %s failed its integrity check", path, snapshotId)
+	}
+
+	return b.store.ImportTree(path, bytes.NewReader(data))
+}
+
+func write(ctx context.Context, o *storage.ObjectHandle, contentType string, data []byte) error {
+	w := o.NewWriter(ctx)
+	w.ContentType = contentType
+	if _, err := w.Write(data); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func read(ctx context.Context, o *storage.ObjectHandle) ([]byte, error) {
+	r, err := o.NewReader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
@@ -0,0 +1,154 @@
+package v1
+
+import (
+	"context"
+	"firebase.google.com/go/db"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// BalanceConfig holds the role tuning values read by the role assigner and matchmaker.
+// Every published config is kept under balance_configs/versions and one of them is active.
+type BalanceConfig struct {
+	Version     int                `json:"version"`
+	RoleWeights map[string]float64 `json:"role_weights,omitempty"` // map of character bin and its pick weight
+	Brackets    []*PlayerBracket   `json:"brackets,omitempty"`
+	Notes       string             `json:"notes,omitempty"`
+	CreatedBy   string             `json:"created_by,omitempty"`
+	CreatedAt   string             `json:"created_at,omitempty"`
+}
+
+// PlayerBracket caps role counts for games within a player count range
+type PlayerBracket struct {
+	MinPlayers int            `json:"min_players"`
+	MaxPlayers int            `json:"max_players"`
+	MaxCounts  map[string]int `json:"max_counts,omitempty"` // map of character bin and max count
+}
+
+// BracketFor returns the bracket covering the player count or nil
+func (c *BalanceConfig) BracketFor(players int) *PlayerBracket {
+	for _, b := range c.Brackets {
+		if b != nil && players >= b.MinPlayers && players <= b.MaxPlayers {
+			return b
+		}
+	}
+	return nil
+}
+
+// MaxCount returns the max number of a role for a player count, -1 when unbounded
+func (c *BalanceConfig) MaxCount(role string, players int) int {
+	b := c.BracketFor(players)
+	if b == nil {
+		return -1
+	}
+	n, ok := b.MaxCounts[role]
+	if !ok {
+		return -1
+	}
+	return n
+}
+
+// Validate checks weights and brackets
+func (c *BalanceConfig) Validate() error {
+	for role, w := range c.RoleWeights {
+		if w < 0 {
+			return fmt.Errorf("negative weight for role: %s", role)
+		}
+	}
+	for i, b := range c.Brackets {
+		if b == nil {
+			return fmt.Errorf("bracket %d is empty", i)
+		}
+		if b.MinPlayers < 1 || b.MaxPlayers < b.MinPlayers {
+			return fmt.Errorf("bracket %d has an invalid player range %d-%d", i, b.MinPlayers, b.MaxPlayers)
+		}
+		for role, n := range b.MaxCounts {
+			if n < 0 {
+				return fmt.Errorf("bracket %d has a negative max count for role: %s", i, role)
+			}
+		}
+	}
+	return nil
+}
+
+func balanceVersionKey(version int) string {
+	return "v" + strconv.Itoa(version)
+}
+
+// PublishBalanceConfig stores the config as a new version and optionally activates it
+func (store *Store) PublishBalanceConfig(c *BalanceConfig, activate bool) (int, error) {
+
+	if c == nil {
+		return 0, fmt.Errorf("invalid balance config object")
+	}
+	if err := c.Validate(); err != nil {
+		return 0, err
+	}
+
+	// reserve the next version number
+	var version int
+	err := store.TransactionPath(context.Background(), "balance_configs/latest_version", func(t db.TransactionNode) (interface{}, error) {
+		var current int
+		if err := t.Unmarshal(&current); err != nil {
+			return nil, err
+		}
+		version = current + 1
+		return version, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	c.Version = version
+	if c.CreatedAt == "" {
+		c.CreatedAt = time.Now().UTC().Format(time.RFC3339)
+	}
+
+	m := map[string]interface{}{
+		"versions/" + balanceVersionKey(version): c,
+	}
+	if activate {
+		m["active_version"] = version
+	}
+	if err := store.UpdatePath(context.Background(), "balance_configs", m); err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+// ActivateBalanceConfig makes a published version the active one
+func (store *Store) ActivateBalanceConfig(version int) error {
+
+	c, err := store.GetBalanceConfig(version)
+	if err != nil {
+		return err
+	}
+	if c == nil {
+		return fmt.Errorf("balance config version not found: %d", version)
+	}
+	return store.SetPath(context.Background(), "balance_configs/active_version", version)
+}
+
+// GetBalanceConfig returns a specific version or nil if it does not exist
+func (store *Store) GetBalanceConfig(version int) (*BalanceConfig, error) {
+
+	var c *BalanceConfig
+	if err := store.GetPath(context.Background(), "balance_configs/versions/"+balanceVersionKey(version), &c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// GetActiveBalanceConfig returns the active config or nil when none was activated
+func (store *Store) GetActiveBalanceConfig() (*BalanceConfig, error) {
+
+	var version int
+	if err := store.GetPath(context.Background(), "balance_configs/active_version", &version); err != nil {
+		return nil, err
+	}
+	if version == 0 {
+		return nil, nil
+	}
+	return store.GetBalanceConfig(version)
+}
@@ -0,0 +1,312 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+	"google.golang.org/api/bigquery/v2"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BigQuery tables the exporter streams into
+const (
+	TableGameCompletions  = "game_completions"
+	TableVotes            = "votes"
+	TableInvitationFunnel = "invitation_funnel"
+	TableExperiments      = "experiment_assignments"
+)
+
+// invitation funnel stages
+const (
+	FunnelSent     = "sent"
+	FunnelAccepted = "accepted"
+	FunnelDeclined = "declined"
+	FunnelExpired  = "expired"
+	FunnelRemoved  = "removed"
+)
+
+func bqField(name string, typ string, mode string) *bigquery.TableFieldSchema {
+	return &bigquery.TableFieldSchema{Name: name, Type: typ, Mode: mode}
+}
+
+// BigQuerySchemas are the schemas of the exported tables, each partitioned by its time column
+var BigQuerySchemas = map[string]*bigquery.TableSchema{
+	TableGameCompletions: {Fields: []*bigquery.TableFieldSchema{
+		bqField("game_id", "STRING", "REQUIRED"),
+		bqField("group_id", "STRING", "NULLABLE"),
+		bqField("winner_faction", "STRING", "NULLABLE"),
+		bqField("winners", "STRING", "REPEATED"),
+		bqField("gamers", "INTEGER", "REQUIRED"),
+		bqField("survivors", "INTEGER", "REQUIRED"),
+		bqField("cycles", "INTEGER", "NULLABLE"),
+		bqField("start_time", "TIMESTAMP", "NULLABLE"),
+		bqField("end_time", "TIMESTAMP", "REQUIRED"),
+		bqField("duration_seconds", "INTEGER", "NULLABLE"),
+	}},
+	TableVotes: {Fields: []*bigquery.TableFieldSchema{
+		bqField("game_id", "STRING", "REQUIRED"),
+		bqField("cycle", "INTEGER", "REQUIRED"),
+		bqField("step_bin", "STRING", "REQUIRED"),
+		bqField("voter", "STRING", "REQUIRED"),
+		bqField("target", "STRING", "NULLABLE"),
+		bqField("ability", "STRING", "NULLABLE"),
+		bqField("voted_at", "TIMESTAMP", "REQUIRED"),
+	}},
+	TableInvitationFunnel: {Fields: []*bigquery.TableFieldSchema{
+		bqField("invitation_id", "STRING", "REQUIRED"),
+		bqField("player_id", "STRING", "REQUIRED"),
+		bqField("creator_id", "STRING", "NULLABLE"),
+		bqField("game_id", "STRING", "NULLABLE"),
+		bqField("group_id", "STRING", "NULLABLE"),
+		bqField("stage", "STRING", "REQUIRED"),
+		bqField("at", "TIMESTAMP", "REQUIRED"),
+	}},
+	TableExperiments: {Fields: []*bigquery.TableFieldSchema{
+		bqField("player_id", "STRING", "REQUIRED"),
+		bqField("experiment", "STRING", "REQUIRED"),
+		bqField("variant", "STRING", "REQUIRED"),
+		bqField("assigned_at", "TIMESTAMP", "REQUIRED"),
+	}},
+}
+
+var bigQueryPartitions = map[string]string{
+	TableGameCompletions:  "end_time",
+	TableVotes:            "voted_at",
+	TableInvitationFunnel: "at",
+	TableExperiments:      "assigned_at",
+}
+
+// BigQueryExporter streams game completions, votes and the invitation funnel into a dataset. As an
+// EventPublisher it exports a game when it ends and every invitation sent, ExportChanges follows
+// the change feed for invitation answers. Game data is read from the secondary when there is one.
+type BigQueryExporter struct {
+	store   *Store
+	service *bigquery.Service
+	project string
+	dataset string
+}
+
+// NewBigQueryExporter returns an exporter writing to project.dataset
+func NewBigQueryExporter(ctx context.Context, store *Store, project string, dataset string, opts ...option.ClientOption) (*BigQueryExporter, error) {
+
+	if project == "" || dataset == "" {
+		return nil, fmt.Errorf("a project and a dataset are required")
+	}
+	service, err := bigquery.NewService(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing bigquery: %v", err)
+	}
+	return &BigQueryExporter{store: store, service: service, project: project, dataset: dataset}, nil
+}
+
+// EnsureTables creates the exported tables that don't exist yet
+func (x *BigQueryExporter) EnsureTables(ctx context.Context) error {
+
+	for name, schema := range BigQuerySchemas {
+		t := &bigquery.Table{
+			TableReference:   &bigquery.TableReference{ProjectId: x.project, DatasetId: x.dataset, TableId: name},
+			Schema:           schema,
+			TimePartitioning: &bigquery.TimePartitioning{Type: "DAY", Field: bigQueryPartitions[name]},
+		}
+		_, err := x.service.Tables.Insert(x.project, x.dataset, t).Context(ctx).Do()
+		if e, ok := err.(*googleapi.Error); ok && e.Code == http.StatusConflict {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error creating table %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// Publish exports the game of an ended event and the invitation of a created one
+func (x *BigQueryExporter) Publish(ctx context.Context, e *DomainEvent) error {
+
+	switch e.Type {
+	case EventGameEnded:
+		return x.ExportGame(ctx, e.GameId)
+	case EventInvitationCreated:
+		inv, _ := e.Data["invitation"].(string)
+		creator, _ := e.Data["creator_id"].(string)
+		return x.insert(ctx, TableInvitationFunnel, []*bigquery.TableDataInsertAllRequestRows{
+			funnelRow(inv, e.PlayerId, creator, e.GameId, e.GroupId, FunnelSent, e.Time),
+		})
+	}
+	return nil
+}
+
+// ExportGame streams the completion and every vote of an ended game
+func (x *BigQueryExporter) ExportGame(ctx context.Context, gameId string) error {
+
+	// the results were just written by EndGame, so they come from the primary
+	r, err := x.store.GetGameResults(gameId)
+	if err != nil {
+		return err
+	}
+	if r == nil {
+		return fmt.Errorf("no results to export for game %s", gameId)
+	}
+	survivors := 0
+	for _, g := range r.Gamers {
+		if g != nil && g.Survived {
+			survivors++
+		}
+	}
+	completion := map[string]bigquery.JsonValue{
+		"game_id":          r.GameBin,
+		"group_id":         r.GroupId,
+		"winner_faction":   r.WinnerFaction,
+		"winners":          r.Winners,
+		"gamers":           len(r.Gamers),
+		"survivors":        survivors,
+		"cycles":           r.Cycles,
+		"end_time":         r.EndTime,
+		"duration_seconds": r.DurationSeconds,
+	}
+	if r.StartTime != "" {
+		completion["start_time"] = r.StartTime
+	}
+	if err := x.insert(ctx, TableGameCompletions, []*bigquery.TableDataInsertAllRequestRows{{InsertId: gameId, Json: completion}}); err != nil {
+		return err
+	}
+
+	var cycles map[string]map[string]map[string][]*models.Result
+	if err := x.store.GetPath(OnSecondary(ctx), "games/"+gameId+"/cycle_results", &cycles); err != nil {
+		return err
+	}
+	var rows []*bigquery.TableDataInsertAllRequestRows
+	for key, steps := range cycles {
+		cycle, _ := strconv.Atoi(strings.TrimPrefix(key, "c"))
+		for stepId, byGamer := range steps {
+			for voter, results := range byGamer {
+				for _, res := range results {
+					if res == nil {
+						continue
+					}
+					at, err := ParseTime(res.TimeStamp)
+					if err != nil {
+						continue
+					}
+					rows = append(rows, &bigquery.TableDataInsertAllRequestRows{InsertId: res.Bin, Json: map[string]bigquery.JsonValue{
+						"game_id":  gameId,
+						"cycle":    cycle,
+						"step_bin": stepId,
+						"voter":    voter,
+						"target":   res.Vote.Target,
+						"ability":  res.Vote.Ability,
+						"voted_at": FormatTime(at),
+					}})
+				}
+			}
+		}
+	}
+	return x.insert(ctx, TableVotes, rows)
+}
+
+// ExportChanges exports the invitation answers and experiment assignments recorded in the change
+// feed after cursor and returns the cursor to resume from. Only writes to
+// players/{id}/invitations/{bin} and players/{id}/experiments/{name} are seen.
+func (x *BigQueryExporter) ExportChanges(ctx context.Context, cursor int64, limit int) (int64, error) {
+
+	changes, next, err := x.store.ReadChangesSince(cursor, limit)
+	if err != nil {
+		return cursor, err
+	}
+	var rows, assignments []*bigquery.TableDataInsertAllRequestRows
+	for _, c := range changes {
+		parts := strings.Split(strings.Trim(c.Path, "/"), "/")
+		if len(parts) != 4 || parts[0] != "players" {
+			continue
+		}
+		if parts[2] == "experiments" && c.Op == OpSet {
+			a := &ExperimentAssignment{}
+			if err := x.store.GetPath(OnSecondary(ctx), c.Path, a); err != nil {
+				return cursor, err
+			}
+			if a.Variant != "" {
+				assignments = append(assignments, &bigquery.TableDataInsertAllRequestRows{
+					InsertId: parts[1] + "." + parts[3],
+					Json: map[string]bigquery.JsonValue{
+						"player_id":   parts[1],
+						"experiment":  a.Experiment,
+						"variant":     a.Variant,
+						"assigned_at": a.AssignedAt,
+					},
+				})
+			}
+			continue
+		}
+		if parts[2] != "invitations" {
+			continue
+		}
+		at := FormatTime(time.UnixMilli(c.TimeStamp))
+		if c.Op == OpDelete {
+			rows = append(rows, funnelRow(parts[3], parts[1], "", "", "", FunnelRemoved, at))
+			continue
+		}
+		inv := &models.Invitation{}
+		if err := x.store.GetPath(OnSecondary(ctx), c.Path, inv); err != nil {
+			return cursor, err
+		}
+		stage := FunnelSent
+		switch {
+		case inv.Accepted:
+			stage = FunnelAccepted
+		case inv.Declined:
+			stage = FunnelDeclined
+		case inv.Status == "expired":
+			stage = FunnelExpired
+		}
+		rows = append(rows, funnelRow(parts[3], parts[1], inv.CreatorId, inv.GameId, inv.GameGroup, stage, at))
+	}
+	if err := x.insert(ctx, TableInvitationFunnel, rows); err != nil {
+		return cursor, err
+	}
+	if err := x.insert(ctx, TableExperiments, assignments); err != nil {
+		return cursor, err
+	}
+	return next, nil
+}
+
+func funnelRow(invitationId string, playerId string, creatorId string, gameId string, groupId string, stage string, at string) *bigquery.TableDataInsertAllRequestRows {
+	return &bigquery.TableDataInsertAllRequestRows{
+		// the id lets bigquery drop a stage streamed twice
+		InsertId: invitationId + "." + stage,
+		Json: map[string]bigquery.JsonValue{
+			"invitation_id": invitationId,
+			"player_id":     playerId,
+			"creator_id":    creatorId,
+			"game_id":       gameId,
+			"group_id":      groupId,
+			"stage":         stage,
+			"at":            at,
+		},
+	}
+}
+
+// insert streams rows into a table, failing when bigquery rejects any of them
+func (x *BigQueryExporter) insert(ctx context.Context, table string, rows []*bigquery.TableDataInsertAllRequestRows) error {
+
+	if len(rows) == 0 {
+		return nil
+	}
+	resp, err := x.service.Tabledata.InsertAll(x.project, x.dataset, table, &bigquery.TableDataInsertAllRequest{Rows: rows}).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("error exporting to %s: %v", table, err)
+	}
+	if len(resp.InsertErrors) > 0 {
+		for _, e := range resp.InsertErrors {
+			for _, p := range e.Errors {
+				log.Printf("Error exporting row %d to %s: %s", e.Index, table, p.Message)
+			}
+		}
+		return fmt.Errorf("bigquery rejected %d of %d rows of %s", len(resp.InsertErrors), len(rows), table)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// BlockPlayer blocks a player for blockerId, stored under players/{blocker}/blocked/{blocked}. It
+// also ends any friendship and drops pending friend requests between the two.
+func (store *Store) BlockPlayer(blockerId string, blockedId string) error {
+
+	if blockerId == "" || blockedId == "" || blockerId == blockedId {
+		return fmt.Errorf("invalid block")
+	}
+	return store.UpdatePath(context.Background(), "/", map[string]interface{}{
+		"players/" + blockerId + "/blocked/" + blockedId:         map[string]interface{}{"at": time.Now().UTC().Format(time.RFC3339)},
+		"players/" + blockerId + "/friends/" + blockedId:         nil,
+		"players/" + blockedId + "/friends/" + blockerId:         nil,
+		"players/" + blockerId + "/friend_requests/" + blockedId: nil,
+		"players/" + blockedId + "/friend_requests/" + blockerId: nil,
+	})
+}
+
+// UnblockPlayer lifts a block
+func (store *Store) UnblockPlayer(blockerId string, blockedId string) error {
+	return store.DeletePath(context.Background(), "players/"+blockerId+"/blocked/"+blockedId)
+}
+
+// GetBlockedPlayers returns the ids a player blocked
+func (store *Store) GetBlockedPlayers(playerId string) ([]string, error) {
+	return store.GetShallowKeys("players/" + playerId + "/blocked")
+}
+
+// IsBlocked reports whether either player blocked the other
+func (store *Store) IsBlocked(a string, b string) (bool, error) {
+
+	for _, pair := range [][2]string{{a, b}, {b, a}} {
+		var entry map[string]interface{}
+		if err := store.GetShallowPath(context.Background(), "players/"+pair[0]+"/blocked/"+pair[1], &entry); err != nil {
+			return false, err
+		}
+		if entry != nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkNotBlocked fails when playerId and any of others blocked one another
+func (store *Store) checkNotBlocked(playerId string, others []string) error {
+
+	for _, o := range others {
+		if o == "" || o == playerId {
+			continue
+		}
+		blocked, err := store.IsBlocked(playerId, o)
+		if err != nil {
+			return err
+		}
+		if blocked {
+			return fmt.Errorf("players %s and %s blocked each other", playerId, o)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	models "github.com/horcu/pm-models/types"
+	"strings"
+)
+
+// botPrefix starts the bin of every bot gamer so bots can be told apart without a read
+const botPrefix = "bot-"
+
+// BotProfile describes a bot gamer, stored under games/{id}/bots/{gamerBin}
+type BotProfile struct {
+	Name       string `json:"name"`
+	ImageUrl   string `json:"image_url,omitempty"`
+	Difficulty string `json:"difficulty,omitempty"`
+	Service    string `json:"service,omitempty"` // the bot service driving it
+}
+
+// BotPlayer is what a bot knows about the other gamers
+type BotPlayer struct {
+	Bin     string `json:"bin"`
+	Name    string `json:"name"`
+	IsAlive bool   `json:"is_alive"`
+}
+
+// BotView is the part of a game a bot's character is allowed to know
+type BotView struct {
+	GameId    string                `json:"game_id"`
+	Status    string                `json:"status"`
+	Cycles    int                   `json:"cycles"`
+	Self      *models.Gamer         `json:"self"`
+	Character *models.GameCharacter `json:"character,omitempty"`
+	Step      *models.Step          `json:"step,omitempty"`
+	Players   []*BotPlayer          `json:"players"`
+	Teammates []string              `json:"teammates,omitempty"`
+}
+
+// IsBot reports whether a gamer or player id belongs to a bot
+func IsBot(id string) bool {
+	return strings.HasPrefix(id, botPrefix)
+}
+
+// AddBotToGame adds a bot gamer to a game that hasn't started, counting towards the player limit
+func (store *Store) AddBotToGame(gameId string, profile *BotProfile) (*models.Gamer, error) {
+
+	if profile == nil || profile.Name == "" {
+		return nil, fmt.Errorf("invalid bot profile")
+	}
+	ctx := context.Background()
+	var status string
+	if err := store.GetPath(ctx, "games/"+gameId+"/status", &status); err != nil {
+		return nil, err
+	}
+	if status == "" {
+		return nil, fmt.Errorf("game not found: %s", gameId)
+	}
+	if status == "started" || status == "ended" || status == GameOrphaned {
+		return nil, fmt.Errorf("game %s already %s", gameId, status)
+	}
+	rules, err := store.GetGameRuleset(gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	gamer := &models.Gamer{Bin: botPrefix + uuid.New().String(), GameId: gameId, Name: profile.Name, ImageUrl: profile.ImageUrl, IsAlive: true}
+	if err := store.SetPath(ctx, "games/"+gameId+"/bots/"+gamer.Bin, profile); err != nil {
+		return nil, err
+	}
+	if err := store.addGamer(ctx, gameId, gamer, rules.MaxPlayers); err != nil {
+		_ = store.DeletePath(ctx, "games/"+gameId+"/bots/"+gamer.Bin)
+		return nil, err
+	}
+	return gamer, nil
+}
+
+// RemoveBotFromGame takes a bot out of a game
+func (store *Store) RemoveBotFromGame(gameId string, botId string) error {
+
+	if !IsBot(botId) {
+		return fmt.Errorf("%s is not a bot", botId)
+	}
+	err := store.UpdatePath(context.Background(), "games/"+gameId, map[string]interface{}{
+		"gamers/" + botId: nil,
+		"bots/" + botId:   nil,
+	})
+	if err != nil {
+		return err
+	}
+	store.refreshGameSummary(gameId, nil)
+	store.promoteGameWaitlist(gameId)
+	return nil
+}
+
+// GetGameBots returns the profiles of a game's bots by gamer bin
+func (store *Store) GetGameBots(gameId string) (map[string]*BotProfile, error) {
+
+	var m map[string]*BotProfile
+	if err := store.GetPath(context.Background(), "games/"+gameId+"/bots", &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetBotView returns what a bot's character knows of its game: itself and its character, the other
+// gamers without their characters or fates, its teammates and the current step without anyone
+// else's results
+func (store *Store) GetBotView(gameId string, botId string) (*BotView, error) {
+
+	if !IsBot(botId) {
+		return nil, fmt.Errorf("%s is not a bot", botId)
+	}
+	ctx := context.Background()
+	g := &models.Game{}
+	if err := store.GetPath(ctx, "games/"+gameId, g); err != nil {
+		return nil, err
+	}
+	self := g.Gamers[botId]
+	if self == nil {
+		return nil, fmt.Errorf("bot %s is not in game %s", botId, gameId)
+	}
+
+	view := &BotView{GameId: gameId, Status: g.Status, Cycles: g.NightCycles, Self: self}
+	if self.CharacterId != "" {
+		view.Character = g.Characters[self.CharacterId]
+	}
+	for bin, gm := range g.Gamers {
+		if gm != nil && bin != botId {
+			view.Players = append(view.Players, &BotPlayer{Bin: bin, Name: gm.Name, IsAlive: gm.IsAlive})
+		}
+	}
+
+	step, err := store.resolveGameStep(ctx, gameId, g.CurrentStep)
+	if err != nil {
+		return nil, err
+	}
+	if step != nil {
+		s := *step
+		s.Result = map[string][]*models.Result{botId: step.Result[botId]}
+		s.SubSteps = nil
+		view.Step = &s
+	}
+
+	team, err := store.GetGamerTeam(gameId, botId)
+	if err != nil {
+		return nil, err
+	}
+	if team != nil {
+		for _, m := range team.Members {
+			if m != botId {
+				view.Teammates = append(view.Teammates, m)
+			}
+		}
+	}
+	return view, nil
+}
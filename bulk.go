@@ -0,0 +1,172 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+	"sync"
+)
+
+// defaultBulkWorkers is how many writes a bulk operation runs at once unless WithBulkWorkers says otherwise
+const defaultBulkWorkers = 8
+
+// WithBulkWorkers bounds how many writes the bulk operations run concurrently
+func WithBulkWorkers(n int) StoreOption {
+	return func(store *Store) {
+		store.bulkWorkers = n
+	}
+}
+
+type bulkWrite struct {
+	path string
+	v    interface{}
+}
+
+// writeBulk runs the writes over a bounded pool of workers and returns every failure joined.
+// Writes not yet started when ctx is cancelled are skipped and reported once as ctx's error.
+func (store *Store) writeBulk(ctx context.Context, writes []bulkWrite) error {
+
+	workers := store.bulkWorkers
+	if workers <= 0 {
+		workers = defaultBulkWorkers
+	}
+	if workers > len(writes) {
+		workers = len(writes)
+	}
+
+	var (
+		mu   sync.Mutex
+		errs []error
+		wg   sync.WaitGroup
+	)
+	jobs := make(chan bulkWrite)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for w := range jobs {
+				if err := store.SetPath(ctx, w.path, w.v); err != nil {
+					mu.Lock()
+					errs = append(errs, fmt.Errorf("error writing %s: %v", w.path, err))
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+feed:
+	for _, w := range writes {
+		select {
+		case jobs <- w:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// versionedWrites stamps each record with the schema version and pairs it with its path
+func versionedWrites[T any](dataType string, records map[string]*T, bin func(*T) string) ([]bulkWrite, error) {
+
+	writes := make([]bulkWrite, 0, len(records))
+	for _, r := range records {
+		if r == nil {
+			continue
+		}
+		v, err := withVersion(r)
+		if err != nil {
+			return nil, err
+		}
+		writes = append(writes, bulkWrite{path: dataType + "/" + bin(r), v: v})
+	}
+	return writes, nil
+}
+
+// AddAllCharactersToDbContext writes the characters concurrently, stopping when ctx is cancelled
+func (store *Store) AddAllCharactersToDbContext(ctx context.Context, chars map[string]*models.GameCharacter) error {
+
+	writes, err := versionedWrites("characters", chars, func(c *models.GameCharacter) string { return c.Bin })
+	if err != nil {
+		return err
+	}
+	return store.writeBulk(ctx, writes)
+}
+
+// AddAllStepsToDbContext writes the steps concurrently, stopping when ctx is cancelled
+func (store *Store) AddAllStepsToDbContext(ctx context.Context, steps map[string]*models.Step) error {
+
+	writes, err := versionedWrites("steps", steps, func(s *models.Step) string { return s.Bin })
+	if err != nil {
+		return err
+	}
+	return store.writeBulk(ctx, writes)
+}
+
+// AddAbilitiesToDbContext writes the abilities concurrently, stopping when ctx is cancelled
+func (store *Store) AddAbilitiesToDbContext(ctx context.Context, abilities map[string]*models.Ability) error {
+
+	writes, err := versionedWrites("abilities", abilities, func(a *models.Ability) string { return a.Bin })
+	if err != nil {
+		return err
+	}
+	return store.writeBulk(ctx, writes)
+}
+
+// GetByBins reads records of a data type over the bounded worker pool. It returns the records
+// found by bin and the error of every bin that couldn't be read, missing records are in neither.
+func (store *Store) GetByBins(dataType string, bins []string) (map[string]interface{}, map[string]error) {
+
+	found := make(map[string]interface{}, len(bins))
+	failed := make(map[string]error)
+	if _, err := newRecord(dataType); err != nil {
+		for _, b := range bins {
+			failed[b] = err
+		}
+		return found, failed
+	}
+
+	workers := store.bulkWorkers
+	if workers <= 0 {
+		workers = defaultBulkWorkers
+	}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	sem := make(chan struct{}, workers)
+	for _, b := range bins {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(b string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			var raw interface{}
+			err := store.GetPath(context.Background(), dataType+"/"+b, &raw)
+			var t interface{}
+			if err == nil && raw != nil {
+				t, _ = newRecord(dataType)
+				err = decodeVersioned(dataType, raw, t)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err != nil:
+				failed[b] = err
+			case t != nil:
+				found[b] = t
+			}
+		}(b)
+	}
+	wg.Wait()
+	return found, failed
+}
@@ -0,0 +1,223 @@
+package v1
+
+import (
+	"context"
+	"firebase.google.com/go/db"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// change operations
+const (
+	OpSet         = "set"
+	OpUpdate      = "update"
+	OpDelete      = "delete"
+	OpTransaction = "transaction"
+)
+
+// opSkipped marks a reserved sequence number whose mutation failed, readers step over it
+const opSkipped = "skipped"
+
+// changeGapGrace is how long a reader waits for a reserved but unwritten sequence number before
+// skipping it. Sets, updates and deletes write their change in the same update as their data, so a
+// number still missing after the grace belongs to a writer that stopped before writing anything.
+const changeGapGrace = 30 * time.Second
+
+// Change is a compact record of one mutation, appended under changes/{seq}
+type Change struct {
+	Seq       int64  `json:"seq"`
+	Path      string `json:"path"`
+	Op        string `json:"op"`
+	TimeStamp int64  `json:"timestamp"` // unix milliseconds
+	Actor     string `json:"actor,omitempty"`
+}
+
+type unrecordedKey struct{}
+
+// unrecorded returns a context whose mutations stay out of the change feed, for scratch nodes
+func unrecorded(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unrecordedKey{}, true)
+}
+
+func isUnrecorded(ctx context.Context) bool {
+	on, _ := ctx.Value(unrecordedKey{}).(bool)
+	return on
+}
+
+func changeKey(seq int64) string {
+	return fmt.Sprintf("s%012d", seq)
+}
+
+// EnableChangeFeed makes every mutation append a change record, attributed to actor (e.g. the service name)
+func (store *Store) EnableChangeFeed(actor string) {
+	store.changeFeed = true
+	store.changeActor = actor
+}
+
+// DisableChangeFeed stops recording changes
+func (store *Store) DisableChangeFeed() {
+	store.changeFeed = false
+}
+
+// reserveChange takes the next sequence number and returns the change record of a mutation. The
+// feed's own nodes are written with raw refs so they never record themselves.
+func (store *Store) reserveChange(ctx context.Context, path string, op string) (*Change, error) {
+
+	var seq int64
+	err := store.NewRef("changes_seq").Transaction(ctx, func(t db.TransactionNode) (interface{}, error) {
+		var current int64
+		if err := t.Unmarshal(&current); err != nil {
+			return nil, err
+		}
+		seq = current + 1
+		return seq, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reserving change sequence for %s: %v", path, err)
+	}
+	return &Change{
+		Seq:       seq,
+		Path:      path,
+		Op:        op,
+		TimeStamp: time.Now().UnixMilli(),
+		Actor:     store.changeActor,
+	}, nil
+}
+
+// changeUpdate returns the root multi-path update that applies a set, update or delete of path,
+// nil for mutations that can't be expressed as one (transactions, pushes and writes of the root)
+func changeUpdate(op string, path string, v interface{}) map[string]interface{} {
+
+	p := strings.Trim(path, "/")
+	switch op {
+	case OpSet:
+		if p == "" {
+			return nil
+		}
+		return map[string]interface{}{p: v}
+	case OpDelete:
+		if p == "" {
+			return nil
+		}
+		return map[string]interface{}{p: nil}
+	case OpUpdate:
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		out := make(map[string]interface{}, len(m)+1)
+		for k, x := range m {
+			k = strings.Trim(k, "/")
+			if p != "" {
+				k = p + "/" + k
+			}
+			out[k] = x
+		}
+		return out
+	}
+	return nil
+}
+
+// withChange wraps the write of a mutation so its change record is written in the same multi-path
+// update as the data, a failed write leaves a skipped record so readers don't wait for it. Paths on
+// a game shard are split from the primary's change record by the sharded update.
+func (store *Store) withChange(ctx context.Context, op string, path string, v interface{}, write func(ctx context.Context) error) (func(ctx context.Context) error, bool, error) {
+
+	m := changeUpdate(op, path, v)
+	if !store.changeFeed || m == nil || isUnrecorded(ctx) {
+		return write, false, nil
+	}
+	c, err := store.reserveChange(ctx, path, op)
+	if err != nil {
+		return nil, false, err
+	}
+	m["changes/"+changeKey(c.Seq)] = c
+	return func(ctx context.Context) error {
+		var err error
+		if store.shards != nil {
+			err = store.updateShardedRoot(ctx, m)
+		} else {
+			err = store.NewRef("/").Update(ctx, m)
+		}
+		if err != nil {
+			skipped := &Change{Seq: c.Seq, Op: opSkipped, TimeStamp: time.Now().UnixMilli()}
+			if serr := store.NewRef("changes/"+changeKey(c.Seq)).Set(context.Background(), skipped); serr != nil {
+				log.Printf("Error skipping change %d for %s: %v", c.Seq, path, serr)
+			}
+		}
+		return err
+	}, true, nil
+}
+
+// recordChange appends the change record of a mutation that couldn't carry it in its own write
+func (store *Store) recordChange(ctx context.Context, path string, op string) {
+
+	if !store.changeFeed || isUnrecorded(ctx) {
+		return
+	}
+	c, err := store.reserveChange(ctx, path, op)
+	if err != nil {
+		log.Print(err)
+		return
+	}
+	if err := store.NewRef("changes/"+changeKey(c.Seq)).Set(ctx, c); err != nil {
+		log.Printf("Error recording change %d for %s: %v", c.Seq, path, err)
+	}
+}
+
+// ReadChangesSince returns up to limit changes after the cursor in sequence order and the cursor to
+// resume from. Reading stops at a missing sequence number until it has been missing for longer than
+// the grace period, so a consumer that persists the returned cursor sees every change once.
+func (store *Store) ReadChangesSince(cursor int64, limit int) ([]*Change, int64, error) {
+
+	if limit <= 0 {
+		limit = 100
+	}
+
+	q := store.NewRef("changes").OrderByKey().StartAt(changeKey(cursor + 1)).LimitToFirst(limit)
+	nodes, err := store.GetOrderedQuery(context.Background(), "changes", q)
+	if err != nil {
+		return nil, cursor, err
+	}
+
+	horizon := time.Now().Add(-changeGapGrace).UnixMilli()
+	var changes []*Change
+	next := cursor
+	for _, n := range nodes {
+		c := &Change{}
+		if err := n.Unmarshal(c); err != nil {
+			return nil, cursor, err
+		}
+		if c.Seq != next+1 && c.TimeStamp > horizon {
+			// a writer may still be about to fill the gap
+			break
+		}
+		next = c.Seq
+		if c.Op == opSkipped {
+			continue
+		}
+		changes = append(changes, c)
+	}
+	return changes, next, nil
+}
+
+// TrimChanges deletes change records up to and including seq once every consumer has read them
+func (store *Store) TrimChanges(seq int64) error {
+
+	q := store.NewRef("changes").OrderByKey().EndAt(changeKey(seq))
+	nodes, err := store.GetOrderedQuery(context.Background(), "changes", q)
+	if err != nil {
+		return err
+	}
+	m := make(map[string]interface{}, len(nodes))
+	for _, n := range nodes {
+		m[n.Key()] = nil
+	}
+	if len(m) == 0 {
+		return nil
+	}
+	// the ops helpers apply dry runs, maintenance and the journal, the trim itself stays out of the feed
+	return store.UpdatePath(unrecorded(context.Background()), "changes", m)
+}
@@ -0,0 +1,143 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+	"io"
+	"sort"
+)
+
+// ChatChannelGame is the channel of the game-wide chat, team channels are "team:{bin}"
+const ChatChannelGame = "game"
+
+// ChatLine is a single line of an exported chat.
+//
+// An export is newline delimited JSON ordered by time, messages with the same time keep the
+// game channel first. Timestamps are unix milliseconds (0 when unknown).
+type ChatLine struct {
+	Seq        int    `json:"seq"`
+	Channel    string `json:"channel"`
+	TimeStamp  int64  `json:"timestamp"`
+	StepBin    string `json:"step_bin,omitempty"`
+	Source     string `json:"source"` // the game bin for system messages
+	SourceName string `json:"source_name,omitempty"`
+	Target     string `json:"target,omitempty"`
+	Text       string `json:"text,omitempty"`
+	Emote      string `json:"emote,omitempty"` // catalog id of an emote message
+}
+
+func teamChannel(teamId string) string {
+	return "team:" + teamId
+}
+
+// ExportGameChat writes the chat history of a finished game to w as the requester can read it:
+// the game channel, the channel of their own team and the spectator channel when they were in
+// it or the host bridged it. An empty requester exports every channel, for moderators handling
+// disputes.
+func (store *Store) ExportGameChat(gameId string, requesterId string, w io.Writer) error {
+
+	ctx := context.Background()
+	var status string
+	if err := store.GetPath(ctx, "games/"+gameId+"/status", &status); err != nil {
+		return err
+	}
+	if status == "" {
+		return fmt.Errorf("game not found: %s", gameId)
+	}
+	if status != "ended" {
+		return fmt.Errorf("game %s has not ended", gameId)
+	}
+
+	var gamers map[string]*models.Gamer
+	if err := store.GetPath(ctx, "games/"+gameId+"/gamers", &gamers); err != nil {
+		return err
+	}
+	if requesterId != "" {
+		if _, ok := gamers[requesterId]; !ok {
+			s := &Spectator{}
+			if err := store.GetPath(ctx, "games/"+gameId+"/spectators/"+requesterId, s); err != nil {
+				return err
+			}
+			if s.PlayerId == "" {
+				return fmt.Errorf("player %s did not play in or watch game %s", requesterId, gameId)
+			}
+		}
+	}
+
+	channels := map[string]string{ChatChannelGame: "games/" + gameId + "/messages"}
+	spectating := requesterId == ""
+	if !spectating {
+		var err error
+		if spectating, err = store.canReadSpectatorChat(gameId, requesterId); err != nil {
+			return err
+		}
+	}
+	if spectating {
+		channels[ChatChannelSpectators] = "games/" + gameId + "/spectator_chat"
+	}
+	teams, err := store.GetGameTeams(gameId)
+	if err != nil {
+		return err
+	}
+	for bin, t := range teams {
+		if t == nil {
+			continue
+		}
+		if requesterId == "" || contains(t.Members, requesterId) {
+			channels[teamChannel(bin)] = "games/" + gameId + "/team_chat/" + bin
+		}
+	}
+
+	var lines []*ChatLine
+	for channel, path := range channels {
+		var m map[string]*models.Message
+		if err := store.GetPath(ctx, path, &m); err != nil {
+			return err
+		}
+		for _, msg := range m {
+			if msg == nil {
+				continue
+			}
+			l := &ChatLine{
+				Channel:   channel,
+				TimeStamp: parseStamp(msg.Timestamp),
+				StepBin:   msg.StepBin,
+				Source:    msg.Source,
+				Target:    msg.Target,
+			}
+			if g := gamers[msg.Source]; g != nil {
+				l.SourceName = g.Name
+			}
+			if msg.Payload != nil && msg.Payload.Type == MediaEmote {
+				l.Emote = msg.Payload.Icon
+			} else if msg.Payload != nil {
+				l.Text = msg.Payload.Text
+			}
+			lines = append(lines, l)
+		}
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool {
+		if lines[i].TimeStamp != lines[j].TimeStamp {
+			return lines[i].TimeStamp < lines[j].TimeStamp
+		}
+		if (lines[i].Channel == ChatChannelGame) != (lines[j].Channel == ChatChannelGame) {
+			return lines[i].Channel == ChatChannelGame
+		}
+		if lines[i].Channel != lines[j].Channel {
+			return lines[i].Channel < lines[j].Channel
+		}
+		return lines[i].Source < lines[j].Source
+	})
+
+	enc := json.NewEncoder(w)
+	for i, l := range lines {
+		l.Seq = i + 1
+		if err := enc.Encode(l); err != nil {
+			return err
+		}
+	}
+	return nil
+}
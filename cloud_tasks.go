@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"google.golang.org/api/cloudtasks/v2"
+	"google.golang.org/api/option"
+	"strings"
+	"time"
+)
+
+// TaskSecretHeader carries the shared secret of Cloud Tasks requests to the deferred action target
+const TaskSecretHeader = "X-PM-Task-Secret"
+
+// CloudTasksQueue is an ActionQueue creating a Cloud Task per action, which posts
+// {"bin": ...} to the target url when the action is due. The target should check the task
+// secret and call RunDeferredAction.
+type CloudTasksQueue struct {
+	service        *cloudtasks.Service
+	queue          string // projects/{project}/locations/{location}/queues/{queue}
+	target         string
+	ServiceAccount string // signs an oidc token for the target when set
+	Secret         string // sent in the TaskSecretHeader when set
+}
+
+// NewCloudTasksQueue returns a queue creating tasks on queue that call target
+func NewCloudTasksQueue(ctx context.Context, queue string, target string, opts ...option.ClientOption) (*CloudTasksQueue, error) {
+
+	if !strings.HasPrefix(queue, "projects/") || target == "" {
+		return nil, fmt.Errorf("a queue name (projects/.../queues/...) and a target url are required")
+	}
+	service, err := cloudtasks.NewService(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing cloud tasks: %v", err)
+	}
+	return &CloudTasksQueue{service: service, queue: queue, target: target}, nil
+}
+
+// Enqueue creates the action's task, named after its bin so it is only ever created once
+func (q *CloudTasksQueue) Enqueue(ctx context.Context, a *DeferredAction) error {
+
+	body, err := json.Marshal(map[string]string{"bin": a.Bin})
+	if err != nil {
+		return err
+	}
+	req := &cloudtasks.HttpRequest{
+		HttpMethod: "POST",
+		Url:        q.target,
+		Headers:    map[string]string{"Content-Type": "application/json"},
+		Body:       base64.StdEncoding.EncodeToString(body),
+	}
+	if q.Secret != "" {
+		req.Headers[TaskSecretHeader] = q.Secret
+	}
+	if q.ServiceAccount != "" {
+		req.OidcToken = &cloudtasks.OidcToken{ServiceAccountEmail: q.ServiceAccount}
+	}
+	task := &cloudtasks.Task{
+		Name:         q.queue + "/tasks/" + a.Bin,
+		ScheduleTime: time.Unix(a.RunAt, 0).UTC().Format(time.RFC3339),
+		HttpRequest:  req,
+	}
+	_, err = q.service.Projects.Locations.Queues.Tasks.Create(q.queue, &cloudtasks.CreateTaskRequest{Task: task}).Context(ctx).Do()
+	return err
+}
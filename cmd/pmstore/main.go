@@ -0,0 +1,304 @@
+// Command pmstore is the operator tool for the game database.
+//
+//	pmstore list games|players|groups
+//	pmstore get games|players|groups <bin>
+//	pmstore delete games|players|groups <bin>
+//	pmstore seed <fixtures.yaml>
+//	pmstore migrate games|players|groups|characters|abilities
+//	pmstore archive <gameId>|-finished|-cold-after days
+//	pmstore users [-count n] [-seed n] [-photos url,url]
+//	pmstore maintenance on|off [message]
+//
+// The connection is read from FIREBASE_URL, PROJECT_ID and either FIREBASE_API_KEY (the
+// service account json) or FIREBASE_CREDENTIALS_FILE, loading a .env file when present.
+// -prefix (or PM_ROOT_PREFIX) selects the environment namespace, e.g. envs/staging.
+// -dry-run logs the writes a command would make instead of making them. Exports and scans read
+// from FIREBASE_SECONDARY_URL when it is set. Cold archives go to COLD_STORAGE_BUCKET under
+// COLD_STORAGE_PREFIX. pmstore keeps writing while the database is in maintenance mode.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+	v1 "github.com/horcu/pm-store"
+	"github.com/horcu/pm-store/fixtures"
+	"github.com/joho/godotenv"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+)
+
+var dataTypes = map[string]string{
+	"games":   "games",
+	"players": "players",
+	"groups":  "game_groups",
+}
+
+var prefix = flag.String("prefix", "", "root path prefix of the environment, defaults to PM_ROOT_PREFIX")
+var dryRun = flag.Bool("dry-run", false, "log writes instead of making them")
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() < 1 {
+		usage()
+		os.Exit(2)
+	}
+
+	store, err := connect()
+	if err != nil {
+		fail(err)
+	}
+
+	args := flag.Args()[1:]
+	switch flag.Arg(0) {
+	case "list":
+		err = list(store, args)
+	case "get":
+		err = get(store, args)
+	case "delete":
+		err = remove(store, args)
+	case "seed":
+		err = seed(store, args)
+	case "migrate":
+		err = migrate(store, args)
+	case "archive":
+		err = archive(store, args)
+	case "users":
+		err = users(store, args)
+	case "maintenance":
+		err = maintenance(store, args)
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fail(err)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: pmstore [-prefix path] [-dry-run] list|get|delete|seed|migrate|archive|users|maintenance [args]")
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "pmstore:", err)
+	os.Exit(1)
+}
+
+func connect() (*v1.Store, error) {
+
+	_ = godotenv.Load()
+
+	key := os.Getenv("FIREBASE_API_KEY")
+	if file := os.Getenv("FIREBASE_CREDENTIALS_FILE"); file != "" {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		key = string(b)
+	}
+	if os.Getenv("FIREBASE_URL") == "" || key == "" {
+		return nil, fmt.Errorf("FIREBASE_URL and FIREBASE_API_KEY or FIREBASE_CREDENTIALS_FILE must be set")
+	}
+
+	if *prefix == "" {
+		*prefix = os.Getenv("PM_ROOT_PREFIX")
+	}
+	opts := []v1.StoreOption{v1.WithRootPrefix(*prefix), v1.WithMaintenanceBypass()}
+	if *dryRun {
+		opts = append(opts, v1.WithDryRun())
+	}
+	if url := os.Getenv("FIREBASE_SECONDARY_URL"); url != "" {
+		opts = append(opts, v1.WithSecondary(url))
+	}
+	if bucket := os.Getenv("COLD_STORAGE_BUCKET"); bucket != "" {
+		opts = append(opts, v1.WithColdStorage(bucket, os.Getenv("COLD_STORAGE_PREFIX")))
+	}
+	store := v1.NewStore(opts...)
+	if err := store.Connect(os.Getenv("FIREBASE_URL"), key, os.Getenv("PROJECT_ID")); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+func dataType(name string) (string, error) {
+	t, ok := dataTypes[name]
+	if !ok {
+		return "", fmt.Errorf("unknown type %q, expected games, players or groups", name)
+	}
+	return t, nil
+}
+
+func list(store *v1.Store, args []string) error {
+
+	if len(args) != 1 {
+		return fmt.Errorf("usage: pmstore list games|players|groups")
+	}
+	t, err := dataType(args[0])
+	if err != nil {
+		return err
+	}
+
+	bins, err := store.GetShallowKeys(t)
+	if err != nil {
+		return err
+	}
+	for _, b := range bins {
+		fmt.Println(b)
+	}
+	return nil
+}
+
+func get(store *v1.Store, args []string) error {
+
+	if len(args) != 2 {
+		return fmt.Errorf("usage: pmstore get games|players|groups <bin>")
+	}
+	t, err := dataType(args[0])
+	if err != nil {
+		return err
+	}
+	return store.ExportTree(t+"/"+args[1], os.Stdout)
+}
+
+func remove(store *v1.Store, args []string) error {
+
+	if len(args) != 2 {
+		return fmt.Errorf("usage: pmstore delete games|players|groups <bin>")
+	}
+	t, err := dataType(args[0])
+	if err != nil {
+		return err
+	}
+	switch t {
+	case "games":
+		return store.Delete(&models.Game{Bin: args[1]}, t)
+	case "players":
+		return store.Delete(&models.Player{Bin: args[1]}, t)
+	default:
+		return store.Delete(&models.Group{Bin: args[1]}, t)
+	}
+}
+
+func seed(store *v1.Store, args []string) error {
+
+	if len(args) != 1 {
+		return fmt.Errorf("usage: pmstore seed <fixtures file>")
+	}
+	f, err := fixtures.NewLoader(store).LoadFixtures(context.Background(), args[0])
+	if err != nil {
+		return err
+	}
+	fmt.Printf("seeded %d players, %d groups, %d characters, %d abilities, %d steps\n",
+		len(f.Players), len(f.Groups), len(f.Characters), len(f.Abilities), len(f.Steps))
+	return nil
+}
+
+func migrate(store *v1.Store, args []string) error {
+
+	if len(args) != 1 {
+		return fmt.Errorf("usage: pmstore migrate <type>")
+	}
+	t := args[0]
+	if mapped, ok := dataTypes[t]; ok {
+		t = mapped
+	}
+	n, err := store.MigrateRecords(t)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("migrated %d %s to schema version %d\n", n, t, v1.SchemaVersion)
+	return nil
+}
+
+func archive(store *v1.Store, args []string) error {
+
+	fs := flag.NewFlagSet("archive", flag.ExitOnError)
+	finished := fs.Bool("finished", false, "archive every game with status ended")
+	coldAfter := fs.Int("cold-after", 0, "move games that ended this many days ago to cold storage")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *coldAfter > 0 {
+		moved, err := store.ArchiveGamesOlderThan(time.Duration(*coldAfter) * 24 * time.Hour)
+		for _, id := range moved {
+			fmt.Println("moved", id, "to cold storage")
+		}
+		return err
+	}
+
+	var ids []string
+	if *finished {
+		games, err := store.GetShallowKeys("games")
+		if err != nil {
+			return err
+		}
+		for _, id := range games {
+			var status string
+			if err := store.GetPath(context.Background(), "games/"+id+"/status", &status); err != nil {
+				return err
+			}
+			if status == "ended" {
+				ids = append(ids, id)
+			}
+		}
+	} else if fs.NArg() == 1 {
+		ids = []string{fs.Arg(0)}
+	} else {
+		return fmt.Errorf("usage: pmstore archive <gameId>|-finished|-cold-after days")
+	}
+
+	for _, id := range ids {
+		if _, err := store.ArchiveGame(id); err != nil {
+			return fmt.Errorf("error archiving %s: %v", id, err)
+		}
+		fmt.Println("archived", id)
+	}
+	return nil
+}
+
+func users(store *v1.Store, args []string) error {
+
+	fs := flag.NewFlagSet("users", flag.ExitOnError)
+	count := fs.Int("count", 10, "number of players to create")
+	seed := fs.Int64("seed", 0, "random seed, the same seed creates the same players, defaults to the time")
+	photos := fs.String("photos", "", "comma separated photo urls to pick from")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+
+	o := v1.RandomUserOptions{Count: *count}
+	if *photos != "" {
+		o.Photos = strings.Split(*photos, ",")
+	}
+	created, err := store.GenerateRandomUsers(rand.New(rand.NewSource(*seed)), o)
+	for _, p := range created {
+		fmt.Println(p.Bin, p.UserName)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("created %d players with seed %d\n", len(created), *seed)
+	return nil
+}
+
+func maintenance(store *v1.Store, args []string) error {
+
+	if len(args) < 1 || (args[0] != "on" && args[0] != "off") {
+		return fmt.Errorf("usage: pmstore maintenance on|off [message]")
+	}
+	on := args[0] == "on"
+	if err := store.SetMaintenance(on, strings.Join(args[1:], " "), "pmstore"); err != nil {
+		return err
+	}
+	fmt.Println("maintenance mode", args[0])
+	return nil
+}
@@ -0,0 +1,126 @@
+package v1
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// coalescer merges updates to the same ref made within a window into one Update call
+type coalescer struct {
+	mu      sync.Mutex
+	window  time.Duration
+	pending map[string]*pendingMerge // merged updates by ref path
+}
+
+// pendingMerge is the update merged for a ref and the timer that writes it once the window ends
+type pendingMerge struct {
+	m     map[string]interface{}
+	timer *time.Timer
+}
+
+// WithCoalescing merges hot path updates such as UpdateGamer to the same ref made within window
+// into a single write. Coalesced updates are acknowledged before they are written, failures are
+// only logged.
+func WithCoalescing(window time.Duration) StoreOption {
+	return func(store *Store) {
+		store.coalesce = &coalescer{
+			window:  window,
+			pending: make(map[string]*pendingMerge),
+		}
+	}
+}
+
+// coalesceUpdate queues m for the ref at path, writing it straight away when coalescing is off
+func (store *Store) coalesceUpdate(path string, m map[string]interface{}) error {
+
+	c := store.coalesce
+	if c == nil {
+		return store.UpdatePath(context.Background(), path, m)
+	}
+
+	c.mu.Lock()
+	p, ok := c.pending[path]
+	if ok && conflicts(p.m, m) {
+		// an ancestor and descendant can't share an update, write what is merged so far first and
+		// stop its timer so it doesn't cut the next merge's window short
+		p.timer.Stop()
+		delete(c.pending, path)
+		c.mu.Unlock()
+		store.writeCoalesced(path, p.m)
+		c.mu.Lock()
+		p, ok = c.pending[path]
+	}
+	if !ok {
+		p = &pendingMerge{m: make(map[string]interface{}, len(m))}
+		c.pending[path] = p
+		merge := p
+		p.timer = time.AfterFunc(c.window, func() { store.flushMerge(path, merge) })
+	}
+	for k, v := range m {
+		p.m[k] = v
+	}
+	c.mu.Unlock()
+	return nil
+}
+
+func conflicts(merged map[string]interface{}, m map[string]interface{}) bool {
+	for k := range m {
+		if overlaps(merged, k) {
+			return true
+		}
+	}
+	return false
+}
+
+func (store *Store) flushCoalesced(path string) {
+
+	c := store.coalesce
+	c.mu.Lock()
+	p, ok := c.pending[path]
+	delete(c.pending, path)
+	c.mu.Unlock()
+	if ok {
+		p.timer.Stop()
+		store.writeCoalesced(path, p.m)
+	}
+}
+
+// flushMerge writes a merge when its window ends, unless it was already written and replaced
+func (store *Store) flushMerge(path string, merge *pendingMerge) {
+
+	c := store.coalesce
+	c.mu.Lock()
+	p, ok := c.pending[path]
+	if !ok || p != merge {
+		c.mu.Unlock()
+		return
+	}
+	delete(c.pending, path)
+	c.mu.Unlock()
+	store.writeCoalesced(path, p.m)
+}
+
+func (store *Store) writeCoalesced(path string, m map[string]interface{}) {
+	if err := store.UpdatePath(context.Background(), path, m); err != nil {
+		log.Printf("Error writing coalesced update to %s: %v", path, err)
+	}
+}
+
+// FlushCoalesced writes every pending coalesced update now, e.g. before shutting down
+func (store *Store) FlushCoalesced() {
+
+	if store.coalesce == nil {
+		return
+	}
+	store.coalesce.mu.Lock()
+	paths := make([]string, 0, len(store.coalesce.pending))
+	for path := range store.coalesce.pending {
+		paths = append(paths, path)
+	}
+	store.coalesce.mu.Unlock()
+	for _, path := range paths {
+		store.flushCoalesced(path)
+	}
+}
@@ -0,0 +1,210 @@
+package v1
+
+import (
+	"cloud.google.com/go/storage"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+)
+
+// ColdGame is the index entry of a game moved to cold storage, kept under cold_games/{gameId}
+type ColdGame struct {
+	Bin        string `json:"bin"`
+	Bucket     string `json:"bucket"`
+	Object     string `json:"object"`
+	Size       int64  `json:"size"`
+	ArchivedAt string `json:"archived_at"`
+}
+
+// coldStorage is the bucket ended games are moved to
+type coldStorage struct {
+	bucket string
+	prefix string
+}
+
+// WithColdStorage sets the Cloud Storage bucket and object prefix, e.g. "archive/prod", ended
+// games are moved to by MoveGameToColdStorage
+func WithColdStorage(bucket string, prefix string) StoreOption {
+	return func(store *Store) {
+		store.cold = &coldStorage{bucket: bucket, prefix: strings.Trim(prefix, "/")}
+	}
+}
+
+func (c *coldStorage) object(gameId string) string {
+	if c.prefix == "" {
+		return "games/" + gameId + ".json"
+	}
+	return c.prefix + "/games/" + gameId + ".json"
+}
+
+func (store *Store) coldBucket(ctx context.Context, name string) (*storage.BucketHandle, error) {
+
+	if store.app == nil {
+		return nil, fmt.Errorf("store is not connected")
+	}
+	client, err := store.app.Storage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return client.Bucket(name)
+}
+
+// MoveGameToColdStorage archives an ended game, writes the archive to cold storage as JSON and
+// deletes the hot copies of the game, its archive and its summary along with the join code,
+// allocation, shard map entry and player references pointing at it. Match history entries stay
+// and are marked cold, GetArchivedGame reads the game back from the bucket.
+func (store *Store) MoveGameToColdStorage(gameId string) (*ColdGame, error) {
+
+	if store.cold == nil {
+		return nil, fmt.Errorf("no cold storage is configured")
+	}
+	ctx := context.Background()
+	var status, code string
+	if err := store.GetPath(ctx, "games/"+gameId+"/status", &status); err != nil {
+		return nil, err
+	}
+	if status == "" {
+		return nil, fmt.Errorf("game not found: %s", gameId)
+	}
+	if status != "ended" {
+		return nil, fmt.Errorf("game %s has not ended", gameId)
+	}
+	if err := store.GetPath(ctx, "games/"+gameId+"/join_code", &code); err != nil {
+		return nil, err
+	}
+	archived, err := store.ArchiveGame(gameId)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := store.storedArchive(archived)
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(stored)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket, err := store.coldBucket(ctx, store.cold.bucket)
+	if err != nil {
+		return nil, err
+	}
+	c := &ColdGame{
+		Bin:        gameId,
+		Bucket:     store.cold.bucket,
+		Object:     store.cold.object(gameId),
+		Size:       int64(len(b)),
+		ArchivedAt: FormatTime(time.Now()),
+	}
+	w := bucket.Object(c.Object).NewWriter(ctx)
+	w.ContentType = "application/json"
+	if _, err := w.Write(b); err != nil {
+		_ = w.Close()
+		return nil, fmt.Errorf("error writing %s to cold storage: %v", gameId, err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("error writing %s to cold storage: %v", gameId, err)
+	}
+
+	// the object is written, only now can the hot copies go
+	m := map[string]interface{}{
+		"games/" + gameId:          nil,
+		"archive/games/" + gameId:  nil,
+		"game_summaries/" + gameId: nil,
+		"allocations/" + gameId:    nil,
+		"cold_games/" + gameId:     c,
+	}
+	if code != "" {
+		m["join_codes/"+code] = nil
+	}
+	for gamerId := range archived.Game.Gamers {
+		m["match_history/"+gamerId+"/"+gameId+"/cold"] = true
+		if !IsBot(gamerId) {
+			m["player_refs/"+gamerId+"/games/"+gameId] = nil
+		}
+	}
+	if creator := archived.Game.Creator; creator != nil && creator.Bin != "" && !IsBot(creator.Bin) {
+		m["player_refs/"+creator.Bin+"/games/"+gameId] = nil
+	}
+	if err := store.UpdatePath(ctx, "/", m); err != nil {
+		return nil, err
+	}
+	// the update above is routed through the shard map, so the entry goes last
+	if store.shards != nil {
+		if err := store.AssignGameShard(gameId, ""); err != nil {
+			log.Printf("Error removing the shard map entry of %s: %v", gameId, err)
+		}
+	}
+	return c, nil
+}
+
+// ArchiveGamesOlderThan moves every ended game whose summary hasn't changed for age to cold
+// storage and returns their ids. It keeps going past failed games, logging them.
+func (store *Store) ArchiveGamesOlderThan(age time.Duration) ([]string, error) {
+
+	if store.cold == nil {
+		return nil, fmt.Errorf("no cold storage is configured")
+	}
+	cutoff := time.Now().Add(-age)
+	var moved []string
+	cursor := ""
+	for {
+		summaries, next, err := store.ListGameSummaries(SummaryListOptions{Status: "ended", Cursor: cursor, Limit: 100})
+		if err != nil {
+			return moved, err
+		}
+		for _, s := range summaries {
+			ended, err := ParseTime(s.UpdatedAt)
+			if err != nil || ended.After(cutoff) {
+				continue
+			}
+			if _, err := store.MoveGameToColdStorage(s.Bin); err != nil {
+				log.Printf("Error moving game %s to cold storage: %v", s.Bin, err)
+				continue
+			}
+			moved = append(moved, s.Bin)
+		}
+		if next == "" {
+			return moved, nil
+		}
+		cursor = next
+	}
+}
+
+// getColdGame reads a game back from cold storage, nil when it was never moved there
+func (store *Store) getColdGame(gameId string) (*ArchivedGame, error) {
+
+	ctx := context.Background()
+	c := &ColdGame{}
+	if err := store.GetPath(ctx, "cold_games/"+gameId, c); err != nil {
+		return nil, err
+	}
+	if c.Object == "" {
+		return nil, nil
+	}
+	bucket, err := store.coldBucket(ctx, c.Bucket)
+	if err != nil {
+		return nil, err
+	}
+	r, err := bucket.Object(c.Object).NewReader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s from cold storage: %v", gameId, err)
+	}
+	defer r.Close()
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s from cold storage: %v", gameId, err)
+	}
+	a := &ArchivedGame{}
+	if err := json.Unmarshal(b, a); err != nil {
+		return nil, err
+	}
+	if err := a.decompress(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
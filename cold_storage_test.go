@@ -0,0 +1,96 @@
+package v1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	models "github.com/horcu/pm-models/types"
+	v1 "github.com/horcu/pm-store"
+	"github.com/horcu/pm-store/testsupport"
+	"strings"
+	"testing"
+)
+
+// createCycledGame creates a game whose earlier cycle is only kept under cycle_results
+func createCycledGame(t *testing.T, store *v1.Store, status string) *models.Game {
+	t.Helper()
+	g := &models.Game{
+		Bin:         "cycled",
+		Status:      status,
+		NightCycles: 1,
+		Gamers: map[string]*models.Gamer{
+			"alice": {Bin: "alice", GameId: "cycled", IsAlive: true},
+			"bob":   {Bin: "bob", GameId: "cycled", IsAlive: true},
+		},
+	}
+	if err := store.CreateGame(g); err != nil {
+		t.Fatalf("CreateGame: %v", err)
+	}
+	earlier := []*models.Result{{
+		Bin: "r-c0", StepBin: "day", GameBin: g.Bin, GamerId: "alice", TimeStamp: "1000",
+		Vote: models.Vote{Target: "bob"},
+	}}
+	if err := store.SetPath(context.Background(), "games/"+g.Bin+"/cycle_results/c0/day/alice", earlier); err != nil {
+		t.Fatalf("SetPath: %v", err)
+	}
+	return g
+}
+
+func TestArchiveGameKeepsEarlierCycles(t *testing.T) {
+
+	store := testsupport.NewStore(t)
+	g := createCycledGame(t, store, "ended")
+
+	archived, err := store.ArchiveGame(g.Bin)
+	if err != nil {
+		t.Fatalf("ArchiveGame: %v", err)
+	}
+	if got := archived.CycleResults["c0"]["day"]["alice"]; len(got) != 1 || got[0].Bin != "r-c0" {
+		t.Errorf("archived cycle results are %v, want the vote of cycle 0", archived.CycleResults)
+	}
+	if got := archived.Results["day"]; len(got) != 1 || got[0].Bin != "r-c0" {
+		t.Errorf("archived step results are %v, want the vote of cycle 0", got)
+	}
+
+	var buf bytes.Buffer
+	if err := store.ExportReplay(g.Bin, &buf); err != nil {
+		t.Fatalf("ExportReplay: %v", err)
+	}
+	votes := 0
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		e := &v1.ReplayEvent{}
+		if err := json.Unmarshal([]byte(line), e); err != nil {
+			t.Fatalf("invalid replay line %q: %v", line, err)
+		}
+		if e.Type == v1.ReplayVote && e.GamerId == "alice" && e.Target == "bob" {
+			votes++
+		}
+	}
+	if votes != 1 {
+		t.Errorf("replay has %d votes of cycle 0, want 1", votes)
+	}
+}
+
+func TestMoveGameToColdStorageRefusesRunningGames(t *testing.T) {
+
+	store := testsupport.NewStore(t, v1.WithColdStorage("pm-store-test", "tests"))
+	g := createCycledGame(t, store, "started")
+
+	if _, err := store.MoveGameToColdStorage(g.Bin); err == nil {
+		t.Fatal("moving a running game to cold storage succeeded")
+	}
+	var status string
+	if err := store.GetPath(context.Background(), "games/"+g.Bin+"/status", &status); err != nil {
+		t.Fatalf("GetPath: %v", err)
+	}
+	if status != "started" {
+		t.Errorf("game status is %q, want the game left in place", status)
+	}
+	archived, err := store.GetArchivedGame(g.Bin)
+	if err != nil {
+		t.Fatalf("GetArchivedGame: %v", err)
+	}
+	if archived != nil {
+		t.Error("a running game was archived")
+	}
+}
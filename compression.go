@@ -0,0 +1,111 @@
+package v1
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+	"io"
+)
+
+// WithResultCompression makes archives keep step results as gzipped JSON instead of nodes, long
+// games have thousands of them. Archives are decompressed on read either way.
+func WithResultCompression() StoreOption {
+	return func(store *Store) {
+		store.compressResults = true
+	}
+}
+
+// archivedResults is the content of an archive's compressed results
+type archivedResults struct {
+	Results map[string][]*models.Result                       `json:"results,omitempty"`
+	Steps   map[string]map[string][]*models.Result            `json:"steps,omitempty"` // step bin and the step's result node
+	Cycles  map[string]map[string]map[string][]*models.Result `json:"cycles,omitempty"`
+}
+
+// compressed returns a copy of the archive with its results, cycle results and every step's
+// result node moved into CompressedResults, leaving the archive itself untouched
+func (a *ArchivedGame) compressed() (*ArchivedGame, error) {
+
+	r := &archivedResults{Results: a.Results, Steps: make(map[string]map[string][]*models.Result), Cycles: a.CycleResults}
+	out := *a
+	out.Results = nil
+	out.CycleResults = nil
+	if a.Game != nil {
+		game := *a.Game
+		game.Steps = make(map[string]*models.Step, len(a.Game.Steps))
+		for bin, step := range a.Game.Steps {
+			if step == nil {
+				continue
+			}
+			s := *step
+			if s.Result != nil {
+				r.Steps[bin] = s.Result
+				s.Result = nil
+			}
+			game.Steps[bin] = &s
+		}
+		out.Game = &game
+	}
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(b); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	out.CompressedResults = base64.StdEncoding.EncodeToString(buf.Bytes())
+	return &out, nil
+}
+
+// decompress restores the results of an archive read back in compressed form
+func (a *ArchivedGame) decompress() error {
+
+	if a.CompressedResults == "" {
+		return nil
+	}
+	raw, err := base64.StdEncoding.DecodeString(a.CompressedResults)
+	if err != nil {
+		return fmt.Errorf("error decoding the results of archive %s: %v", a.Bin, err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return fmt.Errorf("error decompressing the results of archive %s: %v", a.Bin, err)
+	}
+	b, err := io.ReadAll(zr)
+	if err != nil {
+		return fmt.Errorf("error decompressing the results of archive %s: %v", a.Bin, err)
+	}
+	r := &archivedResults{}
+	if err := json.Unmarshal(b, r); err != nil {
+		return fmt.Errorf("error decoding the results of archive %s: %v", a.Bin, err)
+	}
+
+	a.Results = r.Results
+	a.CycleResults = r.Cycles
+	if a.Game != nil {
+		for bin, result := range r.Steps {
+			if step := a.Game.Steps[bin]; step != nil {
+				step.Result = result
+			}
+		}
+	}
+	a.CompressedResults = ""
+	return nil
+}
+
+// storedArchive returns the form of an archive the store writes
+func (store *Store) storedArchive(a *ArchivedGame) (*ArchivedGame, error) {
+	if !store.compressResults {
+		return a, nil
+	}
+	return a.compressed()
+}
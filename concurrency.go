@@ -0,0 +1,91 @@
+package v1
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// ConcurrencyStats reports how a store's in-flight request limit is being hit
+type ConcurrencyStats struct {
+	Limit     int           // maximum requests in flight, zero when unlimited
+	InFlight  int64         // requests running now
+	Queued    int64         // requests waiting for a slot now
+	MaxQueued int64         // most requests ever waiting at once
+	Waits     int64         // requests that had to wait for a slot
+	WaitTime  time.Duration // total time spent waiting for slots
+}
+
+// limiter is a semaphore bounding the requests a store has in flight
+type limiter struct {
+	slots     chan struct{}
+	inFlight  atomic.Int64
+	queued    atomic.Int64
+	maxQueued atomic.Int64
+	waits     atomic.Int64
+	waitTime  atomic.Int64
+}
+
+// WithMaxInFlight limits the database requests the store runs at once to n, further calls queue
+// until a slot frees up or their timeout passes. It keeps bursts like game setup from exhausting
+// connections or hitting the database's rate limits.
+func WithMaxInFlight(n int) StoreOption {
+	return func(store *Store) {
+		if n > 0 {
+			store.limiter = &limiter{slots: make(chan struct{}, n)}
+		}
+	}
+}
+
+// acquire takes a slot and returns its release. When ctx ends while waiting it gives up and the
+// call made with ctx fails with its error.
+func (l *limiter) acquire(ctx context.Context) func() {
+
+	if l == nil {
+		return func() {}
+	}
+	select {
+	case l.slots <- struct{}{}:
+	default:
+		start := time.Now()
+		queued := l.queued.Add(1)
+		for {
+			max := l.maxQueued.Load()
+			if queued <= max || l.maxQueued.CompareAndSwap(max, queued) {
+				break
+			}
+		}
+		l.waits.Add(1)
+		select {
+		case l.slots <- struct{}{}:
+			l.queued.Add(-1)
+			l.waitTime.Add(int64(time.Since(start)))
+		case <-ctx.Done():
+			l.queued.Add(-1)
+			l.waitTime.Add(int64(time.Since(start)))
+			return func() {}
+		}
+	}
+	l.inFlight.Add(1)
+	return func() {
+		l.inFlight.Add(-1)
+		<-l.slots
+	}
+}
+
+// ConcurrencyStats returns the store's in-flight request counters
+func (store *Store) ConcurrencyStats() ConcurrencyStats {
+
+	l := store.limiter
+	if l == nil {
+		return ConcurrencyStats{}
+	}
+	return ConcurrencyStats{
+		Limit:     cap(l.slots),
+		InFlight:  l.inFlight.Load(),
+		Queued:    l.queued.Load(),
+		MaxQueued: l.maxQueued.Load(),
+		Waits:     l.waits.Load(),
+		WaitTime:  time.Duration(l.waitTime.Load()),
+	}
+}
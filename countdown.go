@@ -0,0 +1,147 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"log"
+	"time"
+)
+
+// serverTimestamp is the rtdb placeholder the server replaces with its own clock in milliseconds
+var serverTimestamp = map[string]interface{}{".sv": "timestamp"}
+
+// Countdown is a named timer of a game under games/{id}/countdowns/{name}. Its times are in
+// server clock milliseconds, so every client renders the same remaining time from its own clock
+// and its offset to the server's.
+type Countdown struct {
+	Name       string `json:"name"`
+	StartedAt  int64  `json:"started_at"`
+	ExpiresAt  int64  `json:"expires_at"`
+	DurationMs int64  `json:"duration_ms"`
+}
+
+// Remaining returns the time left on the countdown for a clock that is offset behind the server's,
+// zero once it expired
+func (c *Countdown) Remaining(now time.Time, offset time.Duration) time.Duration {
+
+	left := time.UnixMilli(c.ExpiresAt).Sub(now.Add(offset))
+	if left < 0 {
+		return 0
+	}
+	return left
+}
+
+// Expired reports whether the countdown ran out for a clock with the given offset to the server's
+func (c *Countdown) Expired(now time.Time, offset time.Duration) bool {
+	return c.Remaining(now, offset) == 0
+}
+
+// ServerTimeOffset measures how far the database clock is ahead of the local one by writing a
+// server timestamp to a scratch node, kept out of the change feed.
+func (store *Store) ServerTimeOffset() (time.Duration, error) {
+
+	ctx := unrecorded(context.Background())
+	if store.isDryRun(ctx) {
+		// the probe is a write, so a dry run trusts the local clock
+		return 0, nil
+	}
+	path := "server_time/" + uuid.New().String()
+
+	sent := time.Now()
+	if err := store.SetPath(ctx, path, serverTimestamp); err != nil {
+		return 0, fmt.Errorf("error probing the server time: %w", err)
+	}
+	var server int64
+	err := store.GetPath(ctx, path, &server)
+	received := time.Now()
+	if delErr := store.DeletePath(ctx, path); delErr != nil {
+		log.Printf("Error deleting server time probe: %v", delErr)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("error probing the server time: %w", err)
+	}
+
+	// the server stamped the write somewhere in the round trip, assume the middle of it
+	local := sent.Add(received.Sub(sent) / 2)
+	return time.UnixMilli(server).Sub(local), nil
+}
+
+// ServerNow returns the database's current time
+func (store *Store) ServerNow() (time.Time, error) {
+
+	offset, err := store.ServerTimeOffset()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Now().Add(offset), nil
+}
+
+// SetCountdown starts, or restarts, a named countdown of a game expiring after duration
+func (store *Store) SetCountdown(gameId string, name string, duration time.Duration) (*Countdown, error) {
+
+	if name == "" || duration <= 0 {
+		return nil, fmt.Errorf("a countdown needs a name and a positive duration")
+	}
+	now, err := store.ServerNow()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Countdown{
+		Name:       name,
+		StartedAt:  now.UnixMilli(),
+		ExpiresAt:  now.Add(duration).UnixMilli(),
+		DurationMs: duration.Milliseconds(),
+	}
+	if err := store.SetPath(context.Background(), "games/"+gameId+"/countdowns/"+name, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// GetCountdown returns a countdown of a game, nil when it isn't set
+func (store *Store) GetCountdown(gameId string, name string) (*Countdown, error) {
+
+	var c *Countdown
+	if err := store.GetPath(context.Background(), "games/"+gameId+"/countdowns/"+name, &c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ClearCountdown removes a countdown of a game
+func (store *Store) ClearCountdown(gameId string, name string) error {
+	return store.DeletePath(context.Background(), "games/"+gameId+"/countdowns/"+name)
+}
+
+// WatchCountdown streams a countdown every time it is set, starting with the current one, and nil
+// when it is cleared, until ctx is done. The channel is closed when the watch stops.
+func (store *Store) WatchCountdown(ctx context.Context, gameId string, name string) <-chan *Countdown {
+
+	ch := make(chan *Countdown, 1)
+	go func() {
+		defer close(ch)
+		started := false
+		store.pollNode(ctx, "games/"+gameId+"/countdowns/"+name, func(raw interface{}) bool {
+			var c *Countdown
+			if raw != nil {
+				c = &Countdown{}
+				if err := decodeRaw(raw, c); err != nil {
+					log.Printf("Error decoding countdown %s of game %s: %v", name, gameId, err)
+					return true
+				}
+			} else if !started {
+				// nothing to report until the countdown is first set
+				return true
+			}
+			started = true
+			select {
+			case ch <- c:
+			case <-ctx.Done():
+			}
+			return true
+		})
+	}()
+	return ch
+}
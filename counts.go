@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// countKeys counts the children of path with a shallow read
+func (store *Store) countKeys(path string) (int, error) {
+
+	var m map[string]interface{}
+	if err := store.GetShallowPath(context.Background(), path, &m); err != nil {
+		return 0, err
+	}
+	return len(m), nil
+}
+
+// CountPlayers returns the number of players
+func (store *Store) CountPlayers() (int, error) {
+	return store.countKeys("players")
+}
+
+// CountGroupMembers returns the number of members of a group
+func (store *Store) CountGroupMembers(groupId string) (int, error) {
+	return store.countKeys("game_groups/" + groupId + "/members")
+}
+
+// CountGames returns the number of games with a status, or of all games when status is empty.
+// Filtered counts read the compact lobby summaries instead of the games.
+func (store *Store) CountGames(status string) (int, error) {
+
+	if status == "" {
+		return store.countKeys("games")
+	}
+
+	var m map[string]json.RawMessage
+	q := store.NewRef("game_summaries").OrderByChild("status_key").StartAt(statusKey(status, "")).EndAt(statusKeyEnd(status))
+	err := store.GetQuery(context.Background(), "game_summaries", q, &m)
+	if err != nil {
+		return 0, err
+	}
+	return len(m), nil
+}
@@ -0,0 +1,135 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	models "github.com/horcu/pm-models/types"
+	"time"
+)
+
+// custom character review states
+const (
+	ReviewPending  = "pending"
+	ReviewApproved = "approved"
+	ReviewRejected = "rejected"
+)
+
+// CustomCharacter is a player made character stored under custom_characters/{ownerId}
+type CustomCharacter struct {
+	OwnerId      string                `json:"owner_id"`
+	Character    *models.GameCharacter `json:"character"`
+	ReviewStatus string                `json:"review_status"`
+	ReviewedBy   string                `json:"reviewed_by,omitempty"`
+	ReviewNote   string                `json:"review_note,omitempty"`
+	CreatedAt    string                `json:"created_at"`
+}
+
+// CreateCustomCharacter validates the character's abilities against the catalog and stores it under the owner pending review
+func (store *Store) CreateCustomCharacter(ownerId string, character *models.GameCharacter) (*CustomCharacter, error) {
+
+	if ownerId == "" {
+		return nil, fmt.Errorf("invalid owner id")
+	}
+	if character == nil || character.Name == "" {
+		return nil, fmt.Errorf("invalid character object")
+	}
+
+	// every ability must already exist in the catalog
+	for key, ab := range character.Abilities {
+		if ab == nil || ab.Bin == "" {
+			return nil, fmt.Errorf("invalid ability reference: %s", key)
+		}
+		found, err := store.GetByBin(ab.Bin, "abilities")
+		if err != nil {
+			return nil, err
+		}
+		if found.(*models.Ability).Bin == "" {
+			return nil, fmt.Errorf("unknown ability: %s", ab.Bin)
+		}
+	}
+
+	if character.Bin == "" {
+		character.Bin = uuid.New().String()
+	}
+
+	c := &CustomCharacter{
+		OwnerId:      ownerId,
+		Character:    character,
+		ReviewStatus: ReviewPending,
+		CreatedAt:    time.Now().UTC().Format(time.RFC3339),
+	}
+	if err := store.SetPath(context.Background(), "custom_characters/"+ownerId+"/"+character.Bin, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// GetCustomCharacter returns an owner's custom character or nil
+func (store *Store) GetCustomCharacter(ownerId string, bin string) (*CustomCharacter, error) {
+
+	var c *CustomCharacter
+	if err := store.GetPath(context.Background(), "custom_characters/"+ownerId+"/"+bin, &c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// GetCustomCharacters returns all custom characters of an owner
+func (store *Store) GetCustomCharacters(ownerId string) (map[string]*CustomCharacter, error) {
+
+	var m map[string]*CustomCharacter
+	if err := store.GetPath(context.Background(), "custom_characters/"+ownerId, &m); err != nil {
+		return nil, err
+	}
+	if m == nil {
+		m = make(map[string]*CustomCharacter)
+	}
+	return m, nil
+}
+
+// ReviewCustomCharacter approves or rejects a custom character
+func (store *Store) ReviewCustomCharacter(ownerId string, bin string, approved bool, reviewerId string, note string) error {
+
+	status := ReviewRejected
+	if approved {
+		status = ReviewApproved
+	}
+	return store.UpdatePath(context.Background(), "custom_characters/"+ownerId+"/"+bin, map[string]interface{}{
+		"review_status": status,
+		"reviewed_by":   reviewerId,
+		"review_note":   note,
+	})
+}
+
+// SetGameAllowsCustomCharacters opts a game in or out of custom characters
+func (store *Store) SetGameAllowsCustomCharacters(gameId string, allow bool) error {
+
+	return store.SetPath(context.Background(), "games/"+gameId+"/allow_custom_characters", allow)
+}
+
+// AddCustomCharacterToGame adds an approved custom character to a game that opted in
+func (store *Store) AddCustomCharacterToGame(gameId string, ownerId string, bin string) error {
+
+	var allowed bool
+	if err := store.GetPath(context.Background(), "games/"+gameId+"/allow_custom_characters", &allowed); err != nil {
+		return err
+	}
+	if !allowed {
+		return errors.New("game does not allow custom characters")
+	}
+
+	c, err := store.GetCustomCharacter(ownerId, bin)
+	if err != nil {
+		return err
+	}
+	if c == nil || c.Character == nil {
+		return fmt.Errorf("custom character not found: %s", bin)
+	}
+	if c.ReviewStatus != ReviewApproved {
+		return fmt.Errorf("custom character is not approved: %s", bin)
+	}
+
+	return store.AddToGame("characters", gameId, c.Character)
+}
@@ -0,0 +1,75 @@
+package v1
+
+import (
+	"context"
+	models "github.com/horcu/pm-models/types"
+)
+
+// GetCurrentCycle returns the game's cycle counter, 0 before the first cycle starts
+func (store *Store) GetCurrentCycle(gameId string) (int, error) {
+
+	var cycle int
+	if err := store.GetPath(context.Background(), "games/"+gameId+"/cycles", &cycle); err != nil {
+		return 0, err
+	}
+	return cycle, nil
+}
+
+// AdvanceCycle starts the game's next cycle holding the step lock and returns its number
+func (store *Store) AdvanceCycle(gameId string) (int, error) {
+
+	var cycle int
+	err := store.withGameLock(gameId, "step", func() error {
+		var err error
+		cycle, err = store.advanceCycle(gameId)
+		return err
+	})
+	return cycle, err
+}
+
+// advanceCycle bumps the counter and clears the steps' results in one update. The cleared results
+// stay in cycle_results/{cycle}, so the steps only ever show the current cycle.
+func (store *Store) advanceCycle(gameId string) (int, error) {
+
+	current, err := store.GetCurrentCycle(gameId)
+	if err != nil {
+		return 0, err
+	}
+	steps, err := store.GetShallowKeys("games/" + gameId + "/steps")
+	if err != nil {
+		return 0, err
+	}
+
+	next := current + 1
+	m := map[string]interface{}{"cycles": next}
+	for _, stepId := range steps {
+		m["steps/"+stepId+"/result"] = nil
+	}
+	if err := store.UpdateGame(gameId, m); err != nil {
+		return 0, err
+	}
+	store.logAction(gameId, &GameAction{Type: ActionCycleAdvance, Data: map[string]interface{}{"cycle": next}})
+	store.logFeed(gameId, &FeedEvent{Type: FeedCycleStarted, Cycle: next})
+	return next, nil
+}
+
+// GetCycleResults returns the results of every step of a cycle, keyed by step and then gamer
+func (store *Store) GetCycleResults(gameId string, cycle int) (map[string]map[string][]*models.Result, error) {
+
+	var m map[string]map[string][]*models.Result
+	if err := store.GetPath(context.Background(), "games/"+gameId+"/cycle_results/"+cycleKey(cycle), &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ResolveCurrentCycle resolves the fates queued in the game's current cycle
+func (store *Store) ResolveCurrentCycle(gameId string) (int, map[string]*FateOutcome, error) {
+
+	cycle, err := store.GetCurrentCycle(gameId)
+	if err != nil {
+		return 0, nil, err
+	}
+	outcomes, err := store.ResolveFates(gameId, cycle)
+	return cycle, outcomes, err
+}
@@ -0,0 +1,283 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"firebase.google.com/go/db"
+	"fmt"
+	"github.com/google/uuid"
+	"log"
+	"time"
+)
+
+// deferred action types
+const (
+	DeferStepAdvance      = "step_advance"      // advance GameId to its next step unless it left StepBin
+	DeferInvitationExpiry = "invitation_expiry" // expire InvitationId of PlayerId unless answered
+	DeferGameExpiry       = "game_expiry"       // end GameId unless it already ended
+)
+
+// deferred action states
+const (
+	DeferredPending   = "pending"
+	DeferredDone      = "done"
+	DeferredFailed    = "failed"
+	DeferredCancelled = "cancelled"
+)
+
+// deferredMaxAttempts is how often a failing action runs before it is marked failed
+const deferredMaxAttempts = 3
+
+// deferredRetryDelay is the wait before a failed action runs again
+const deferredRetryDelay = time.Minute
+
+// deferredLease is how long a claimed action is held before another executor may take it over,
+// so an executor crashing mid-run doesn't lose the action
+const deferredLease = 5 * time.Minute
+
+// DeferredAction is a state transition due at RunAt, stored under deferred_actions/{bin}.
+// PendingAt mirrors RunAt until the action is claimed, then moves to the end of the claim's lease
+// so the due query sees the action again if its executor never records an outcome. It is cleared
+// once the action is done, failed or cancelled.
+type DeferredAction struct {
+	Bin          string                 `json:"bin"`
+	Type         string                 `json:"type"`
+	GameId       string                 `json:"game_id,omitempty"`
+	PlayerId     string                 `json:"player_id,omitempty"`
+	InvitationId string                 `json:"invitation_id,omitempty"`
+	StepBin      string                 `json:"step_bin,omitempty"`
+	Payload      map[string]interface{} `json:"payload,omitempty"`
+	RunAt        int64                  `json:"run_at"` // unix seconds
+	PendingAt    int64                  `json:"pending_at,omitempty"`
+	LeasedUntil  int64                  `json:"leased_until,omitempty"` // unix seconds, while claimed
+	Status       string                 `json:"status"`
+	Attempts     int                    `json:"attempts,omitempty"`
+	Error        string                 `json:"error,omitempty"`
+	CreatedAt    string                 `json:"created_at"`
+}
+
+// ActionQueue hands deferred actions to an external scheduler such as Cloud Tasks, which calls
+// RunDeferredAction when they are due. The executor loop still picks up anything it misses.
+type ActionQueue interface {
+	Enqueue(ctx context.Context, a *DeferredAction) error
+}
+
+// WithActionQueue enqueues every scheduled action on q as well as persisting it
+func WithActionQueue(q ActionQueue) StoreOption {
+	return func(store *Store) {
+		store.actionQueue = q
+	}
+}
+
+// ScheduleAction persists an action to run at the given time
+func (store *Store) ScheduleAction(at time.Time, a *DeferredAction) (*DeferredAction, error) {
+
+	if a == nil || a.Type == "" {
+		return nil, fmt.Errorf("invalid deferred action object")
+	}
+	switch a.Type {
+	case DeferStepAdvance:
+		if a.GameId == "" || a.StepBin == "" {
+			return nil, fmt.Errorf("a %s action needs a game id and the step it advances from", a.Type)
+		}
+	case DeferGameExpiry:
+		if a.GameId == "" {
+			return nil, fmt.Errorf("a %s action needs a game id", a.Type)
+		}
+	case DeferInvitationExpiry:
+		if a.PlayerId == "" || a.InvitationId == "" {
+			return nil, fmt.Errorf("a %s action needs a player and an invitation id", a.Type)
+		}
+	default:
+		return nil, fmt.Errorf("unknown deferred action type: %s", a.Type)
+	}
+
+	if a.Bin == "" {
+		a.Bin = uuid.New().String()
+	}
+	a.RunAt = at.Unix()
+	a.PendingAt = a.RunAt
+	a.Status = DeferredPending
+	a.CreatedAt = time.Now().UTC().Format(time.RFC3339)
+	if err := store.SetPath(context.Background(), "deferred_actions/"+a.Bin, a); err != nil {
+		return nil, err
+	}
+
+	if store.actionQueue != nil {
+		if err := store.actionQueue.Enqueue(context.Background(), a); err != nil {
+			log.Printf("Error enqueueing deferred action %s, the executor will run it: %v", a.Bin, err)
+		}
+	}
+	return a, nil
+}
+
+// GetDeferredAction returns a deferred action, nil when it doesn't exist
+func (store *Store) GetDeferredAction(bin string) (*DeferredAction, error) {
+
+	a := &DeferredAction{}
+	if err := store.GetPath(context.Background(), "deferred_actions/"+bin, a); err != nil {
+		return nil, err
+	}
+	if a.Bin == "" {
+		return nil, nil
+	}
+	return a, nil
+}
+
+// CancelAction stops a pending action from running
+func (store *Store) CancelAction(bin string) error {
+
+	claimed, err := store.claimAction(bin)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return fmt.Errorf("deferred action not pending: %s", bin)
+	}
+	return store.UpdatePath(context.Background(), "deferred_actions/"+bin, map[string]interface{}{
+		"status":       DeferredCancelled,
+		"pending_at":   nil,
+		"leased_until": nil,
+	})
+}
+
+// RunDeferredActions runs due actions every interval until ctx is done
+func (store *Store) RunDeferredActions(ctx context.Context, interval time.Duration) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := store.ProcessDueActions(time.Now()); err != nil {
+			log.Printf("Error processing deferred actions: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessDueActions runs every action due by now and returns how many ran
+func (store *Store) ProcessDueActions(now time.Time) (int, error) {
+
+	q := store.NewRef("deferred_actions").OrderByChild("pending_at").StartAt(1).EndAt(now.Unix())
+	nodes, err := store.GetOrderedQuery(context.Background(), "deferred_actions", q)
+	if err != nil {
+		return 0, err
+	}
+	ran := 0
+	for _, n := range nodes {
+		a := &DeferredAction{}
+		if err := n.Unmarshal(a); err != nil {
+			return ran, err
+		}
+		ok, err := store.claimAction(a.Bin)
+		if err != nil || !ok {
+			continue
+		}
+		store.runAction(a)
+		ran++
+	}
+	return ran, nil
+}
+
+// RunDeferredAction runs one pending action now, e.g. when Cloud Tasks delivers it. An action
+// another caller already claimed is not run again.
+func (store *Store) RunDeferredAction(bin string) error {
+
+	a, err := store.GetDeferredAction(bin)
+	if err != nil {
+		return err
+	}
+	if a == nil {
+		return fmt.Errorf("deferred action not found: %s", bin)
+	}
+	ok, err := store.claimAction(bin)
+	if err != nil || !ok {
+		return err
+	}
+	store.runAction(a)
+	return nil
+}
+
+// claimAction leases a pending action in a transaction and reports whether this caller holds the
+// lease, so several executors never run the same action at once. An expired lease can be claimed
+// again.
+func (store *Store) claimAction(bin string) (bool, error) {
+
+	claimed := false
+	err := store.TransactionPath(context.Background(), "deferred_actions/"+bin, func(t db.TransactionNode) (interface{}, error) {
+		claimed = false
+		var a *DeferredAction
+		if err := t.Unmarshal(&a); err != nil {
+			return nil, err
+		}
+		now := time.Now().Unix()
+		if a == nil || a.Status != DeferredPending || a.PendingAt == 0 || a.LeasedUntil > now {
+			return a, nil
+		}
+		a.LeasedUntil = time.Now().Add(deferredLease).Unix()
+		a.PendingAt = a.LeasedUntil
+		claimed = true
+		return a, nil
+	})
+	return claimed, err
+}
+
+// runAction performs a claimed action and records the outcome, rescheduling failures until they
+// run out of attempts
+func (store *Store) runAction(a *DeferredAction) {
+
+	a.Attempts++
+	m := map[string]interface{}{"attempts": a.Attempts, "status": DeferredDone, "error": nil, "pending_at": nil, "leased_until": nil}
+	if err := store.performAction(a); err != nil {
+		log.Printf("Error running deferred %s action %s: %v", a.Type, a.Bin, err)
+		m["error"] = err.Error()
+		if a.Attempts < deferredMaxAttempts {
+			m["status"] = DeferredPending
+			m["pending_at"] = time.Now().Add(deferredRetryDelay).Unix()
+		} else {
+			m["status"] = DeferredFailed
+		}
+	}
+	if err := store.UpdatePath(context.Background(), "deferred_actions/"+a.Bin, m); err != nil {
+		log.Printf("Error recording deferred action %s: %v", a.Bin, err)
+	}
+}
+
+func (store *Store) performAction(a *DeferredAction) error {
+
+	ctx := context.Background()
+	switch a.Type {
+	case DeferStepAdvance:
+		err := store.advanceStep(a.GameId, a.StepBin)
+		if errors.Is(err, ErrStepChanged) {
+			// another instance already advanced the game
+			return nil
+		}
+		return err
+	case DeferInvitationExpiry:
+		var inv map[string]interface{}
+		path := "players/" + a.PlayerId + "/invitations/" + a.InvitationId
+		if err := store.GetPath(ctx, path, &inv); err != nil {
+			return err
+		}
+		if inv == nil || inv["accepted"] == true || inv["declined"] == true {
+			return nil
+		}
+		return store.UpdatePath(ctx, path, map[string]interface{}{"status": "expired"})
+	case DeferGameExpiry:
+		var status string
+		if err := store.GetPath(ctx, "games/"+a.GameId+"/status", &status); err != nil {
+			return err
+		}
+		if status == "" || status == "ended" {
+			return nil
+		}
+		_, err := store.EndGame(a.GameId)
+		return err
+	default:
+		return fmt.Errorf("unknown deferred action type: %s", a.Type)
+	}
+}
@@ -0,0 +1,81 @@
+package v1_test
+
+import (
+	"context"
+	v1 "github.com/horcu/pm-store"
+	"github.com/horcu/pm-store/testsupport"
+	"testing"
+	"time"
+)
+
+func getAction(t *testing.T, store *v1.Store, bin string) *v1.DeferredAction {
+	t.Helper()
+	a, err := store.GetDeferredAction(bin)
+	if err != nil {
+		t.Fatalf("GetDeferredAction: %v", err)
+	}
+	if a == nil {
+		t.Fatalf("deferred action %s not found", bin)
+	}
+	return a
+}
+
+func TestRunDeferredActionRunsOnce(t *testing.T) {
+
+	store := testsupport.NewStore(t)
+	a, err := store.ScheduleAction(time.Now().Add(-time.Minute), &v1.DeferredAction{
+		Type:         v1.DeferInvitationExpiry,
+		PlayerId:     "alice",
+		InvitationId: "gone",
+	})
+	if err != nil {
+		t.Fatalf("ScheduleAction: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := store.RunDeferredAction(a.Bin); err != nil {
+			t.Fatalf("RunDeferredAction: %v", err)
+		}
+	}
+	got := getAction(t, store, a.Bin)
+	if got.Status != v1.DeferredDone || got.Attempts != 1 {
+		t.Errorf("status %s after %d attempts, want done after 1", got.Status, got.Attempts)
+	}
+	if got.PendingAt != 0 || got.LeasedUntil != 0 {
+		t.Errorf("done action still pending at %d, leased until %d", got.PendingAt, got.LeasedUntil)
+	}
+}
+
+func TestProcessDueActionsHonoursLeases(t *testing.T) {
+
+	store := testsupport.NewStore(t)
+	ctx := context.Background()
+	past := time.Now().Add(-time.Minute).Unix()
+	held := &v1.DeferredAction{
+		Bin: "held", Type: v1.DeferInvitationExpiry, PlayerId: "alice", InvitationId: "gone",
+		RunAt: past, PendingAt: past, LeasedUntil: time.Now().Add(time.Hour).Unix(), Status: v1.DeferredPending,
+	}
+	expired := &v1.DeferredAction{
+		Bin: "expired", Type: v1.DeferInvitationExpiry, PlayerId: "alice", InvitationId: "gone",
+		RunAt: past, PendingAt: past, LeasedUntil: past, Status: v1.DeferredPending,
+	}
+	for _, a := range []*v1.DeferredAction{held, expired} {
+		if err := store.SetPath(ctx, "deferred_actions/"+a.Bin, a); err != nil {
+			t.Fatalf("SetPath: %v", err)
+		}
+	}
+
+	ran, err := store.ProcessDueActions(time.Now())
+	if err != nil {
+		t.Fatalf("ProcessDueActions: %v", err)
+	}
+	if ran != 1 {
+		t.Errorf("ran %d actions, want only the one whose lease expired", ran)
+	}
+	if got := getAction(t, store, held.Bin); got.Status != v1.DeferredPending || got.Attempts != 0 {
+		t.Errorf("leased action is %s after %d attempts, want it left to its executor", got.Status, got.Attempts)
+	}
+	if got := getAction(t, store, expired.Bin); got.Status != v1.DeferredDone {
+		t.Errorf("action with an expired lease is %s, want done", got.Status)
+	}
+}
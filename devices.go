@@ -0,0 +1,151 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"firebase.google.com/go/messaging"
+	"fmt"
+	"log"
+	"time"
+)
+
+// DeviceToken is a push token of one of a player's devices, stored under players/{id}/devices/{deviceId}
+type DeviceToken struct {
+	DeviceId     string `json:"device_id"`
+	Token        string `json:"token"`
+	Platform     string `json:"platform,omitempty"` // android, ios or web
+	Model        string `json:"model,omitempty"`
+	AppVersion   string `json:"app_version,omitempty"`
+	RegisteredAt string `json:"registered_at"`
+	LastSeen     string `json:"last_seen"`
+}
+
+// RegisterDeviceToken adds or refreshes a device's push token. Devices without an id are keyed by their token.
+func (store *Store) RegisterDeviceToken(playerId string, d *DeviceToken) error {
+
+	if d == nil || d.Token == "" {
+		return fmt.Errorf("invalid device token object")
+	}
+	if d.DeviceId == "" {
+		sum := sha256.Sum256([]byte(d.Token))
+		d.DeviceId = hex.EncodeToString(sum[:8])
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	if d.RegisteredAt == "" {
+		d.RegisteredAt = now
+	}
+	d.LastSeen = now
+	return store.SetPath(context.Background(), "players/"+playerId+"/devices/"+d.DeviceId, d)
+}
+
+// UnregisterDeviceToken removes a device, e.g. on sign out
+func (store *Store) UnregisterDeviceToken(playerId string, deviceId string) error {
+	return store.DeletePath(context.Background(), "players/"+playerId+"/devices/"+deviceId)
+}
+
+// GetPlayerDevices returns a player's registered devices
+func (store *Store) GetPlayerDevices(playerId string) (map[string]*DeviceToken, error) {
+
+	var m map[string]*DeviceToken
+	if err := store.GetPath(context.Background(), "players/"+playerId+"/devices", &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetPlayerTokens returns the push tokens of every device of a player, including the single
+// token older clients wrote to players/{id}/token
+func (store *Store) GetPlayerTokens(playerId string) ([]string, error) {
+
+	devices, err := store.GetPlayerDevices(playerId)
+	if err != nil {
+		return nil, err
+	}
+	var tokens []string
+	seen := make(map[string]bool)
+	for _, d := range devices {
+		if d != nil && d.Token != "" && !seen[d.Token] {
+			seen[d.Token] = true
+			tokens = append(tokens, d.Token)
+		}
+	}
+	legacy, err := store.GetPlayerToken(playerId)
+	if err != nil {
+		return nil, err
+	}
+	if legacy != nil && *legacy != "" && !seen[*legacy] {
+		tokens = append(tokens, *legacy)
+	}
+	return tokens, nil
+}
+
+// PruneDeviceTokens removes the devices holding tokens push delivery rejected as unregistered
+func (store *Store) PruneDeviceTokens(playerId string, rejected []string) error {
+
+	if len(rejected) == 0 {
+		return nil
+	}
+	devices, err := store.GetPlayerDevices(playerId)
+	if err != nil {
+		return err
+	}
+	m := make(map[string]interface{})
+	for id, d := range devices {
+		if d != nil && contains(rejected, d.Token) {
+			m["devices/"+id] = nil
+		}
+	}
+	legacy, err := store.GetPlayerToken(playerId)
+	if err != nil {
+		return err
+	}
+	if legacy != nil && contains(rejected, *legacy) {
+		m["token"] = nil
+	}
+	if len(m) == 0 {
+		return nil
+	}
+	return store.UpdatePath(context.Background(), "players/"+playerId, m)
+}
+
+// SendToPlayerDevices pushes a message to every device of a player and prunes the tokens FCM
+// reports as no longer registered. The message's Tokens are filled in by the store.
+func (store *Store) SendToPlayerDevices(ctx context.Context, playerId string, msg *messaging.MulticastMessage) (*messaging.BatchResponse, error) {
+
+	if store.app == nil {
+		return nil, fmt.Errorf("store is not connected")
+	}
+	if IsBot(playerId) {
+		return &messaging.BatchResponse{}, nil
+	}
+	tokens, err := store.GetPlayerTokens(playerId)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return &messaging.BatchResponse{}, nil
+	}
+	client, err := store.app.Messaging(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m := *msg
+	m.Tokens = tokens
+	resp, err := client.SendMulticast(ctx, &m)
+	if err != nil {
+		return nil, err
+	}
+
+	var rejected []string
+	for i, r := range resp.Responses {
+		if r != nil && !r.Success && messaging.IsRegistrationTokenNotRegistered(r.Error) {
+			rejected = append(rejected, tokens[i])
+		}
+	}
+	if err := store.PruneDeviceTokens(playerId, rejected); err != nil {
+		log.Printf("Error pruning device tokens of %s: %v", playerId, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	models "github.com/horcu/pm-models/types"
+	"math/rand"
+)
+
+// RandomUserOptions describes the players RandomUsers generates
+type RandomUserOptions struct {
+	Count     int      // players to generate, len(UserNames) when zero
+	UserNames []string // names used in order, generated ones like player-482193 after them
+	Photos    []string // photo urls picked at random, none when empty
+	Status    string   // defaults to available
+	Privacy   string   // defaults to public
+}
+
+// RandomUsers generates players drawn from rng without writing them. The same seed and options
+// produce the same players, bins included.
+func RandomUsers(rng *rand.Rand, o RandomUserOptions) []*models.Player {
+
+	count := o.Count
+	if count == 0 {
+		count = len(o.UserNames)
+	}
+	if o.Status == "" {
+		o.Status = "available"
+	}
+	if o.Privacy == "" {
+		o.Privacy = "public"
+	}
+
+	players := make([]*models.Player, 0, count)
+	for i := 0; i < count; i++ {
+		p := &models.Player{Status: o.Status, Privacy: o.Privacy}
+		if id, err := uuid.NewRandomFromReader(rng); err == nil {
+			p.Bin = id.String()
+		}
+		if i < len(o.UserNames) {
+			p.UserName = o.UserNames[i]
+		} else {
+			p.UserName = randomUserName(rng)
+		}
+		if len(o.Photos) > 0 {
+			p.Photo = o.Photos[rng.Intn(len(o.Photos))]
+		}
+		players = append(players, p)
+	}
+	return players
+}
+
+// randomUserNameAttempts bounds the names drawn for a generated player whose name is taken
+const randomUserNameAttempts = 5
+
+func randomUserName(rng *rand.Rand) string {
+	return fmt.Sprintf("player-%06d", rng.Intn(1000000))
+}
+
+// GenerateRandomUsers writes the players RandomUsers generates to players, claiming their user
+// names, and returns them. A generated name that is taken is drawn again, a taken name from the
+// options is an error. It stops at the first error, returning the players created so far.
+func (store *Store) GenerateRandomUsers(rng *rand.Rand, o RandomUserOptions) ([]*models.Player, error) {
+
+	var created []*models.Player
+	for i, p := range RandomUsers(rng, o) {
+		err := store.CreatePlayer(p)
+		for attempt := 1; errors.Is(err, ErrUserNameTaken) && i >= len(o.UserNames) && attempt < randomUserNameAttempts; attempt++ {
+			p.UserName = randomUserName(rng)
+			err = store.CreatePlayer(p)
+		}
+		if err != nil {
+			return created, err
+		}
+		created = append(created, p)
+	}
+	return created, nil
+}
@@ -0,0 +1,156 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"firebase.google.com/go/db"
+	"fmt"
+	"log"
+	"time"
+)
+
+// defaultDisconnectGrace is used when the game's ruleset sets no disconnect grace
+const defaultDisconnectGrace = 90 * time.Second
+
+var errTimerGone = errors.New("disconnect timer already handled")
+
+// Presence is a player's connection state, stored under players/{id}/presence
+type Presence struct {
+	Online    bool   `json:"online"`
+	GameId    string `json:"game_id,omitempty"` // the game the client is connected to
+	ChangedAt string `json:"changed_at"`
+}
+
+// disconnectTimer is the grace period of a gamer who dropped out of a started game, stored under
+// disconnect_timers/{gameId}_{playerId}
+type disconnectTimer struct {
+	GameId    string `json:"game_id"`
+	PlayerId  string `json:"player_id"`
+	ExpiresAt int64  `json:"expires_at"` // unix seconds
+}
+
+func disconnectTimerKey(gameId string, playerId string) string {
+	return gameId + "_" + playerId
+}
+
+// SetPresence records a player's connection state. Going offline during a started game starts the
+// game's disconnect grace period, coming back before it expires cancels it and clears any afk mark.
+// The player's status follows, see syncPresenceStatus.
+func (store *Store) SetPresence(playerId string, p *Presence) error {
+
+	if p == nil {
+		return fmt.Errorf("invalid presence object")
+	}
+	ctx := context.Background()
+	var previous Presence
+	if err := store.GetPath(ctx, "players/"+playerId+"/presence", &previous); err != nil {
+		return err
+	}
+	p.ChangedAt = FormatTime(time.Now())
+	// an offline report doesn't know the game, it is the one the player was last connected to
+	if !p.Online && p.GameId == "" {
+		p.GameId = previous.GameId
+	}
+	if err := store.SetPath(ctx, "players/"+playerId+"/presence", p); err != nil {
+		return err
+	}
+	if err := store.syncPresenceStatus(playerId, p); err != nil {
+		log.Printf("Error syncing the status of player %s: %v", playerId, err)
+	}
+	if p.GameId == "" {
+		return nil
+	}
+
+	if p.Online {
+		return store.UpdatePath(ctx, "/", map[string]interface{}{
+			"disconnect_timers/" + disconnectTimerKey(p.GameId, playerId): nil,
+			"games/" + p.GameId + "/afk/" + playerId:                      nil,
+		})
+	}
+	if !previous.Online {
+		return nil
+	}
+	return store.startDisconnectTimer(ctx, p.GameId, playerId)
+}
+
+// startDisconnectTimer starts the grace period of a gamer who is still alive in a started game
+func (store *Store) startDisconnectTimer(ctx context.Context, gameId string, playerId string) error {
+
+	var status string
+	if err := store.GetPath(ctx, "games/"+gameId+"/status", &status); err != nil {
+		return err
+	}
+	var alive bool
+	if err := store.GetPath(ctx, "games/"+gameId+"/gamers/"+playerId+"/is_alive", &alive); err != nil {
+		return err
+	}
+	if status != "started" || !alive {
+		return nil
+	}
+
+	grace := defaultDisconnectGrace
+	rules, err := store.GetGameRuleset(gameId)
+	if err != nil {
+		return err
+	}
+	if d, err := time.ParseDuration(rules.DisconnectGrace); err == nil && d > 0 {
+		grace = d
+	}
+	return store.SetPath(ctx, "disconnect_timers/"+disconnectTimerKey(gameId, playerId), &disconnectTimer{
+		GameId:    gameId,
+		PlayerId:  playerId,
+		ExpiresAt: time.Now().Add(grace).Unix(),
+	})
+}
+
+// GetAFKGamers returns the gamers of a game whose grace period ran out, the host may kick them
+func (store *Store) GetAFKGamers(gameId string) ([]string, error) {
+	return store.GetShallowKeys("games/" + gameId + "/afk")
+}
+
+// processDisconnects marks the gamers whose grace period expired by now afk and tells the host
+func (store *Store) processDisconnects(now time.Time) error {
+
+	ctx := context.Background()
+	q := store.NewRef("disconnect_timers").OrderByChild("expires_at").EndAt(now.Unix())
+	nodes, err := store.GetOrderedQuery(ctx, "disconnect_timers", q)
+	if err != nil {
+		return err
+	}
+	for _, n := range nodes {
+		t := &disconnectTimer{}
+		if err := n.Unmarshal(t); err != nil {
+			return err
+		}
+		// claim the timer so only one scheduler instance handles it
+		err := store.TransactionPath(ctx, "disconnect_timers/"+n.Key(), func(tn db.TransactionNode) (interface{}, error) {
+			current := &disconnectTimer{}
+			if err := tn.Unmarshal(current); err != nil {
+				return nil, err
+			}
+			if current.PlayerId == "" {
+				return nil, errTimerGone
+			}
+			return nil, nil
+		})
+		if errors.Is(err, errTimerGone) {
+			continue
+		}
+		if err != nil {
+			log.Printf("Error claiming disconnect timer %s: %v", n.Key(), err)
+			continue
+		}
+
+		if err := store.SetPath(ctx, "games/"+t.GameId+"/afk/"+t.PlayerId, now.Unix()); err != nil {
+			log.Printf("Error marking %s afk in game %s: %v", t.PlayerId, t.GameId, err)
+			continue
+		}
+		var host string
+		if err := store.GetPath(ctx, "games/"+t.GameId+"/creator/bin", &host); err != nil {
+			log.Printf("Error finding the host of game %s: %v", t.GameId, err)
+		}
+		store.publish(&DomainEvent{Type: EventGamerAFK, GameId: t.GameId, PlayerId: t.PlayerId,
+			Data: map[string]interface{}{"host": host}})
+	}
+	return nil
+}
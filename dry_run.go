@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"firebase.google.com/go/db"
+	"github.com/google/uuid"
+	"log"
+)
+
+type dryRunKey struct{}
+
+// WithDryRun turns every write of the store into a no-op that logs what it would have written,
+// and keeps it from publishing events, so migrations and admin scripts can be previewed safely
+func WithDryRun() StoreOption {
+	return func(store *Store) {
+		store.dryRun = true
+	}
+}
+
+// DryRun returns a context whose writes are only logged. It applies to calls taking a context,
+// like SetPath or UpdatePath, methods that make their own follow the store's WithDryRun option.
+func DryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+// isDryRun reports whether writes made with ctx should only be logged
+func (store *Store) isDryRun(ctx context.Context) bool {
+	on, _ := ctx.Value(dryRunKey{}).(bool)
+	return store.dryRun || on
+}
+
+// logDryRun logs the write that was skipped
+func (store *Store) logDryRun(op string, path string, v interface{}) {
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		b = []byte(err.Error())
+	}
+	log.Printf("Dry run: %s %s %s", op, store.fullPath(path), b)
+}
+
+// dryRunNode is the current value a dry run transaction function is given
+type dryRunNode struct {
+	raw json.RawMessage
+}
+
+func (n *dryRunNode) Unmarshal(v interface{}) error {
+	return json.Unmarshal(n.raw, v)
+}
+
+// dryRunTransaction runs fn against the current value of path and logs its result without writing it
+func (store *Store) dryRunTransaction(ctx context.Context, path string, fn db.UpdateFn) error {
+
+	var raw json.RawMessage
+	if err := store.GetPath(ctx, path, &raw); err != nil {
+		return err
+	}
+	if len(raw) == 0 {
+		raw = json.RawMessage("null")
+	}
+	v, err := fn(&dryRunNode{raw: raw})
+	if err != nil {
+		return wrapErr(OpTransaction, path, err)
+	}
+	store.logDryRun(OpTransaction, path, v)
+	return nil
+}
+
+// dryRunPush logs a push and returns a reference to a child that was never written
+func (store *Store) dryRunPush(path string, v interface{}) *db.Ref {
+	store.logDryRun("push", path, v)
+	return store.NewRef(path).Child("dry-run-" + uuid.New().String())
+}
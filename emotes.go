@@ -0,0 +1,144 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"firebase.google.com/go/db"
+	"fmt"
+	"github.com/horcu/pm-models/enums"
+	models "github.com/horcu/pm-models/types"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// ErrEmoteRateLimited is returned when a gamer sends more emotes than the remote config allows
+var ErrEmoteRateLimited = errors.New("too many emotes")
+
+// MediaEmote is the payload type of emote messages, the payload icon holds the emote id
+const MediaEmote enums.MediaType = "emote"
+
+// emote kinds
+const (
+	EmoteKindEmote  = "emote"
+	EmoteKindPhrase = "phrase"
+)
+
+var emoteIdPattern = regexp.MustCompile(`^[a-z0-9_]{1,32}$`)
+
+// Emote is a catalog entry under emote_catalog/{id}. Gamers can only send emotes from the
+// catalog, so emote messages carry no free text and skip the checks of text chat.
+type Emote struct {
+	Id     string `json:"id"`
+	Kind   string `json:"kind"`
+	Text   string `json:"text"` // the phrase, or the description of an emote
+	Icon   string `json:"icon,omitempty"`
+	Active bool   `json:"active"`
+}
+
+// emoteRate is the per minute emote counter of a gamer under games/{id}/emote_rate/{gamerId}
+type emoteRate struct {
+	Window int64 `json:"window"` // unix minute
+	Count  int   `json:"count"`
+}
+
+// SetEmote adds or replaces a catalog entry
+func (store *Store) SetEmote(e *Emote) error {
+
+	if e == nil || !emoteIdPattern.MatchString(e.Id) || e.Text == "" {
+		return fmt.Errorf("invalid emote object")
+	}
+	if e.Kind != EmoteKindEmote && e.Kind != EmoteKindPhrase {
+		return fmt.Errorf("invalid emote kind: %s", e.Kind)
+	}
+	return store.SetPath(context.Background(), "emote_catalog/"+e.Id, e)
+}
+
+// DeleteEmote removes a catalog entry, messages that used it keep its id
+func (store *Store) DeleteEmote(id string) error {
+	return store.DeletePath(context.Background(), "emote_catalog/"+id)
+}
+
+// GetEmoteCatalog returns every catalog entry by id
+func (store *Store) GetEmoteCatalog() (map[string]*Emote, error) {
+
+	var m map[string]*Emote
+	if err := store.GetPath(context.Background(), "emote_catalog", &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SendEmote posts an active catalog emote from a gamer to the game channel, limited to the
+// remote config's EmotesPerMinute. The message only stores the emote id, its source, step and time.
+func (store *Store) SendEmote(gameId string, gamerId string, emoteId string) (*models.Message, error) {
+
+	ctx := context.Background()
+	e := &Emote{}
+	if err := store.GetPath(ctx, "emote_catalog/"+emoteId, e); err != nil {
+		return nil, err
+	}
+	if e.Id == "" || !e.Active {
+		return nil, fmt.Errorf("unknown emote: %s", emoteId)
+	}
+	var alive bool
+	if err := store.GetPath(ctx, "games/"+gameId+"/gamers/"+gamerId+"/is_alive", &alive); err != nil {
+		return nil, err
+	}
+	if !alive {
+		return nil, fmt.Errorf("gamer %s can't send emotes in game %s", gamerId, gameId)
+	}
+
+	c, err := store.GetRemoteConfig()
+	if err != nil {
+		return nil, err
+	}
+	if err := store.takeEmoteSlot(ctx, gameId, gamerId, c.EmotesPerMinute); err != nil {
+		return nil, err
+	}
+
+	var step string
+	if err := store.GetPath(ctx, "games/"+gameId+"/current_step", &step); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	msg := &models.Message{
+		Source:    gamerId,
+		StepBin:   step,
+		Timestamp: strconv.FormatInt(now.UnixMilli(), 10),
+		Payload:   &models.Media{Type: MediaEmote, Icon: e.Id},
+	}
+	compact := map[string]interface{}{
+		"source":    msg.Source,
+		"timestamp": msg.Timestamp,
+		"payload":   map[string]interface{}{"type": MediaEmote, "icon": e.Id},
+	}
+	if step != "" {
+		compact["step_bin"] = step
+	}
+	// gamers can emote in the same millisecond, the gamer suffix keeps both
+	if err := store.SetPath(ctx, "games/"+gameId+"/messages/"+msg.Timestamp+"_"+gamerId, compact); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// takeEmoteSlot counts an emote against the gamer's current minute
+func (store *Store) takeEmoteSlot(ctx context.Context, gameId string, gamerId string, perMinute int) error {
+
+	window := time.Now().Unix() / 60
+	return store.TransactionPath(ctx, "games/"+gameId+"/emote_rate/"+gamerId, func(t db.TransactionNode) (interface{}, error) {
+		r := &emoteRate{}
+		if err := t.Unmarshal(r); err != nil {
+			return nil, err
+		}
+		if r.Window != window {
+			r = &emoteRate{Window: window}
+		}
+		if r.Count >= perMinute {
+			return nil, fmt.Errorf("%w: %d per minute", ErrEmoteRateLimited, perMinute)
+		}
+		r.Count++
+		return r, nil
+	})
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"google.golang.org/api/option"
+	"net/http"
+	"strings"
+)
+
+// EmulatorHostEnv is the variable the firebase tools use for the database emulator's host:port
+const EmulatorHostEnv = "FIREBASE_DATABASE_EMULATOR_HOST"
+
+// ConnectEmulator connects to the database emulator at host (e.g. localhost:9000) with admin
+// access to namespace. The sdk only talks to https firebaseio.com urls, so requests are sent to
+// the emulator by the transport instead.
+func (store *Store) ConnectEmulator(host string, namespace string) error {
+	client := &http.Client{Transport: &emulatorTransport{host: host}}
+	return store.Publisher.connect("https://"+namespace+".firebaseio.com", namespace, option.WithHTTPClient(client))
+}
+
+// emulatorTransport rewrites https://{ns}.firebaseio.com requests to http://{host}/...?ns={ns}
+type emulatorTransport struct {
+	host string
+}
+
+func (t *emulatorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	r := req.Clone(req.Context())
+	q := r.URL.Query()
+	q.Set("ns", strings.TrimSuffix(r.URL.Hostname(), ".firebaseio.com"))
+	r.URL.RawQuery = q.Encode()
+	r.URL.Scheme = "http"
+	r.URL.Host = t.host
+	r.Host = t.host
+	// the emulator grants admin access to the "owner" token
+	r.Header.Set("Authorization", "Bearer owner")
+	return http.DefaultTransport.RoundTrip(r)
+}
@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+)
+
+// read operations, the write operations are the change operations
+const (
+	OpGet     = "get"
+	OpShallow = "shallow"
+	OpQuery   = "query"
+	OpPush    = "push"
+)
+
+// StoreError is returned by every database call of the store, naming the operation, the path and
+// the kind of entity it failed on. Use errors.Is and errors.As on it to reach the cause.
+type StoreError struct {
+	Op     string
+	Path   string
+	Entity string // the top level node, e.g. games or players
+	Err    error
+}
+
+func (e *StoreError) Error() string {
+	return fmt.Sprintf("store: %s %s: %v", e.Op, e.Path, e.Err)
+}
+
+func (e *StoreError) Unwrap() error {
+	return e.Err
+}
+
+// wrapErr wraps err in a StoreError unless it is nil or already one
+func wrapErr(op string, path string, err error) error {
+
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*StoreError); ok {
+		return err
+	}
+	path = strings.Trim(path, "/")
+	entity, _, _ := strings.Cut(path, "/")
+	if path == "" {
+		path = "/"
+	}
+	return &StoreError{Op: op, Path: path, Entity: entity, Err: err}
+}
@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// ErrETagMismatch is returned by conditional writes when the node changed since its ETag was read
+var ErrETagMismatch = errors.New("node was modified since it was read")
+
+// GetWithETag reads the node at path into v and returns its ETag for a later UpdateIfMatch
+func (store *Store) GetWithETag(path string, v interface{}) (string, error) {
+	ctx, cancel := store.opContext(context.Background(), false)
+	defer cancel()
+	etag, err := store.NewRef(path).GetWithETag(ctx, v)
+	return etag, wrapErr(OpGet, path, err)
+}
+
+// UpdateIfMatch applies the child updates in m, keys may be nested paths and nil deletes, only
+// if the node at path still has the given ETag. It fails with ErrETagMismatch otherwise.
+func (store *Store) UpdateIfMatch(path string, etag string, m map[string]interface{}) error {
+
+	return store.mutateConditional(context.Background(), OpUpdate, path, m, func(ctx context.Context) error {
+		ref, err := store.ref(path)
+		if err != nil {
+			return err
+		}
+
+		// the database only supports conditional replaces, so the update is applied to the
+		// current value and written back under the same ETag
+		var current map[string]interface{}
+		if err := ref.Get(ctx, &current); err != nil {
+			return err
+		}
+		if current == nil {
+			current = make(map[string]interface{})
+		}
+		for k, v := range m {
+			setNested(current, strings.Split(strings.Trim(k, "/"), "/"), v)
+		}
+
+		ok, err := ref.SetIfUnchanged(ctx, etag, current)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ErrETagMismatch
+		}
+		return nil
+	})
+}
+
+// setNested sets the value at the key path inside m, nil removes it
+func setNested(m map[string]interface{}, keys []string, v interface{}) {
+
+	for _, k := range keys[:len(keys)-1] {
+		child, ok := m[k].(map[string]interface{})
+		if !ok {
+			if v == nil {
+				return
+			}
+			child = make(map[string]interface{})
+			m[k] = child
+		}
+		m = child
+	}
+	last := keys[len(keys)-1]
+	if v == nil {
+		delete(m, last)
+		return
+	}
+	m[last] = v
+}
@@ -0,0 +1,97 @@
+package v1
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"log"
+	"time"
+)
+
+// domain event types
+const (
+	EventGameCreated       = "game.created"
+	EventGameStarted       = "game.started"
+	EventGameEnded         = "game.ended"
+	EventStepAdvanced      = "game.step_advanced"
+	EventPlayerReported    = "player.reported"
+	EventInvitationCreated = "invitation.created"
+	EventPlayerCreated     = "player.created"
+	EventScheduleReminder  = "schedule.reminder"
+	EventScheduleStarted   = "schedule.started"
+	EventScheduleCancelled = "schedule.cancelled"
+	EventGameOrphaned      = "game.orphaned"
+	EventGameRecovered     = "game.recovered"
+	EventGamerAFK          = "gamer.afk"
+)
+
+// DomainEvent is published after a successful write
+type DomainEvent struct {
+	Bin      string                 `json:"bin"`
+	Type     string                 `json:"type"`
+	GameId   string                 `json:"game_id,omitempty"`
+	PlayerId string                 `json:"player_id,omitempty"`
+	GroupId  string                 `json:"group_id,omitempty"`
+	Time     string                 `json:"time"`
+	Data     map[string]interface{} `json:"data,omitempty"`
+}
+
+// EventPublisher receives domain events from the store. Publishing errors are logged, never returned to the writer.
+type EventPublisher interface {
+	Publish(ctx context.Context, e *DomainEvent) error
+}
+
+// SetEventPublisher installs the publisher notified after successful writes, nil disables publishing
+func (store *Store) SetEventPublisher(p EventPublisher) {
+	store.events = p
+}
+
+// publish fills in the event's bin and time and hands it to the publisher
+func (store *Store) publish(e *DomainEvent) {
+
+	if store.events == nil || store.dryRun {
+		return
+	}
+	if e.Bin == "" {
+		e.Bin = uuid.New().String()
+	}
+	if e.Time == "" {
+		e.Time = time.Now().UTC().Format(time.RFC3339Nano)
+	}
+	if err := store.events.Publish(context.Background(), e); err != nil {
+		log.Printf("Error publishing %s event: %v", e.Type, err)
+	}
+}
+
+// MultiPublisher hands every event to each of its publishers, returning the first error
+type MultiPublisher []EventPublisher
+
+// Publish publishes to every publisher even when one fails
+func (m MultiPublisher) Publish(ctx context.Context, e *DomainEvent) error {
+	var first error
+	for _, p := range m {
+		if err := p.Publish(ctx, e); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// ChannelPublisher delivers events to an in-process channel
+type ChannelPublisher struct {
+	C chan *DomainEvent
+}
+
+// NewChannelPublisher returns a ChannelPublisher with a buffer of size events
+func NewChannelPublisher(size int) *ChannelPublisher {
+	return &ChannelPublisher{C: make(chan *DomainEvent, size)}
+}
+
+// Publish blocks until the event is buffered or ctx is done
+func (p *ChannelPublisher) Publish(ctx context.Context, e *DomainEvent) error {
+	select {
+	case p.C <- e:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
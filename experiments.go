@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"time"
+)
+
+// ErrExperimentInactive is returned when assigning a player to an experiment that isn't running
+var ErrExperimentInactive = errors.New("experiment is not active")
+
+// Experiment is an A/B test under experiments/{name}, players are split between its variants by weight
+type Experiment struct {
+	Name      string     `json:"name"`
+	Variants  []*Variant `json:"variants"`
+	Active    bool       `json:"active"`
+	CreatedAt string     `json:"created_at"`
+}
+
+// Variant is one arm of an experiment
+type Variant struct {
+	Name   string `json:"name"`
+	Weight int    `json:"weight"` // relative share of the players
+}
+
+// ExperimentAssignment is the variant a player was given, stored under players/{id}/experiments/{name}
+type ExperimentAssignment struct {
+	Experiment string `json:"experiment"`
+	Variant    string `json:"variant"`
+	AssignedAt string `json:"assigned_at"`
+}
+
+// Validate checks the experiment has distinct, positively weighted variants
+func (e *Experiment) Validate() error {
+
+	if e == nil || !flagNamePattern.MatchString(e.Name) || len(e.Variants) < 2 {
+		return fmt.Errorf("invalid experiment object")
+	}
+	seen := make(map[string]bool, len(e.Variants))
+	for _, v := range e.Variants {
+		if v == nil || v.Name == "" || v.Weight <= 0 {
+			return fmt.Errorf("experiment %s has an invalid variant", e.Name)
+		}
+		if seen[v.Name] {
+			return fmt.Errorf("experiment %s has the variant %s twice", e.Name, v.Name)
+		}
+		seen[v.Name] = true
+	}
+	return nil
+}
+
+// variantFor picks the variant of a player, the same player always lands in the same one as long
+// as the variants don't change
+func (e *Experiment) variantFor(playerId string) string {
+
+	total := 0
+	for _, v := range e.Variants {
+		total += v.Weight
+	}
+	h := fnv.New32a()
+	h.Write([]byte(e.Name + ":" + playerId))
+	n := int(h.Sum32() % uint32(total))
+	for _, v := range e.Variants {
+		if n < v.Weight {
+			return v.Name
+		}
+		n -= v.Weight
+	}
+	return e.Variants[len(e.Variants)-1].Name
+}
+
+// SetExperiment creates or replaces an experiment. Changing the variants of a running experiment
+// doesn't move players already assigned.
+func (store *Store) SetExperiment(e *Experiment) error {
+
+	if err := e.Validate(); err != nil {
+		return err
+	}
+	if e.CreatedAt == "" {
+		e.CreatedAt = time.Now().UTC().Format(time.RFC3339)
+	}
+	return store.SetPath(context.Background(), "experiments/"+e.Name, e)
+}
+
+// GetExperiment returns an experiment, nil when it doesn't exist
+func (store *Store) GetExperiment(name string) (*Experiment, error) {
+
+	e := &Experiment{}
+	if err := store.GetPath(context.Background(), "experiments/"+name, e); err != nil {
+		return nil, err
+	}
+	if e.Name == "" {
+		return nil, nil
+	}
+	return e, nil
+}
+
+// AssignExperiment returns the player's variant of an experiment, bucketing and persisting it on
+// the first call
+func (store *Store) AssignExperiment(playerId string, experiment string) (*ExperimentAssignment, error) {
+
+	ctx := context.Background()
+	path := "players/" + playerId + "/experiments/" + experiment
+	a := &ExperimentAssignment{}
+	if err := store.GetPath(ctx, path, a); err != nil {
+		return nil, err
+	}
+	if a.Variant != "" {
+		return a, nil
+	}
+
+	e, err := store.GetExperiment(experiment)
+	if err != nil {
+		return nil, err
+	}
+	if e == nil || !e.Active {
+		return nil, fmt.Errorf("%w: %s", ErrExperimentInactive, experiment)
+	}
+	a = &ExperimentAssignment{
+		Experiment: experiment,
+		Variant:    e.variantFor(playerId),
+		AssignedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	if err := store.SetPath(ctx, path, a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// GetAssignments returns every experiment variant of a player, keyed by experiment
+func (store *Store) GetAssignments(playerId string) (map[string]*ExperimentAssignment, error) {
+
+	var m map[string]*ExperimentAssignment
+	if err := store.GetPath(context.Background(), "players/"+playerId+"/experiments", &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
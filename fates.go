@@ -0,0 +1,307 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/horcu/pm-models/enums"
+	models "github.com/horcu/pm-models/types"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// PendingFate is an ability applied during a cycle, waiting for resolution under games/{id}/pending_fates
+type PendingFate struct {
+	Bin        string `json:"bin"`
+	AbilityBin string `json:"ability_bin"`
+	Ability    string `json:"ability"` // ability name, e.g. kill or heal
+	Source     string `json:"source,omitempty"`
+	Target     string `json:"target"`
+	Cycle      int    `json:"cycle"`
+	TimeStamp  string `json:"timestamp"`
+}
+
+// FateRules decides how fates applied in the same cycle interact, stored under games/{id}/fate_rules
+type FateRules struct {
+	Priority []string            `json:"priority"`          // ability names, highest priority first
+	Cancels  map[string][]string `json:"cancels,omitempty"` // ability and the abilities it cancels on the same target
+	Blocks   []string            `json:"blocks,omitempty"`  // abilities that cancel every action of their target
+	Lethal   []string            `json:"lethal,omitempty"`  // abilities that eliminate their target
+}
+
+// FateOutcome records how the fates applied to one gamer in a cycle were resolved
+type FateOutcome struct {
+	Target     string         `json:"target"`
+	Cycle      int            `json:"cycle"`
+	Applied    []*PendingFate `json:"applied,omitempty"`
+	Cancelled  []*PendingFate `json:"cancelled,omitempty"`
+	Final      *models.Fate   `json:"final,omitempty"`
+	Eliminated bool           `json:"eliminated"`
+}
+
+// DefaultFateRules returns the rules used when a game has none: blocks resolve first,
+// heals cancel kills and poison, hiding cancels kills.
+func DefaultFateRules() *FateRules {
+	return &FateRules{
+		Priority: []string{
+			enums.Block.String(),
+			enums.Hide.String(),
+			enums.Heal.String(),
+			enums.Retaliate.String(),
+			enums.Kill.String(),
+			enums.Poison.String(),
+			enums.Trick.String(),
+			enums.Mimic.String(),
+			enums.Mark.String(),
+			enums.Investigate.String(),
+			enums.Direct.String(),
+		},
+		Cancels: map[string][]string{
+			enums.Heal.String(): {enums.Kill.String(), enums.Poison.String()},
+			enums.Hide.String(): {enums.Kill.String()},
+		},
+		Blocks: []string{enums.Block.String()},
+		Lethal: []string{enums.Kill.String(), enums.Poison.String()},
+	}
+}
+
+func (r *FateRules) rank(ability string) int {
+	for i, a := range r.Priority {
+		if a == ability {
+			return i
+		}
+	}
+	return len(r.Priority)
+}
+
+func (r *FateRules) cancels(by string, ability string) bool {
+	for _, a := range r.Cancels[by] {
+		if a == ability {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func cycleKey(cycle int) string {
+	return "c" + strconv.Itoa(cycle)
+}
+
+// SetGameFateRules attaches fate rules to a game
+func (store *Store) SetGameFateRules(gameId string, r *FateRules) error {
+
+	if r == nil || len(r.Priority) == 0 {
+		return fmt.Errorf("invalid fate rules object")
+	}
+	return store.SetPath(context.Background(), "games/"+gameId+"/fate_rules", r)
+}
+
+// GetGameFateRules returns the fate rules of a game, falling back to DefaultFateRules
+func (store *Store) GetGameFateRules(gameId string) (*FateRules, error) {
+
+	var r *FateRules
+	if err := store.GetPath(context.Background(), "games/"+gameId+"/fate_rules", &r); err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return DefaultFateRules(), nil
+	}
+	return r, nil
+}
+
+// QueueFate records an ability applied by source to target in the game's current cycle
+func (store *Store) QueueFate(abilityBin string, gameBin string, source string, target string) (*PendingFate, error) {
+
+	var cycle int
+	if err := store.GetPath(context.Background(), "games/"+gameBin+"/cycles", &cycle); err != nil {
+		return nil, err
+	}
+
+	// resolve the ability name from the catalog, falling back to the bin
+	name := abilityBin
+	ab, err := store.GetByBin(abilityBin, "abilities")
+	if err != nil {
+		return nil, err
+	}
+	if a := ab.(*models.Ability); a.Name != "" {
+		name = a.Name
+	}
+
+	f := &PendingFate{
+		Bin:        uuid.New().String(),
+		AbilityBin: abilityBin,
+		Ability:    name,
+		Source:     source,
+		Target:     target,
+		Cycle:      cycle,
+		TimeStamp:  strconv.FormatInt(time.Now().UnixMilli(), 10),
+	}
+	if err := store.SetPath(context.Background(), "games/"+gameBin+"/pending_fates/"+cycleKey(cycle)+"/"+f.Bin, f); err != nil {
+		return nil, err
+	}
+	store.logAction(gameBin, &GameAction{Type: ActionAbility, GamerId: source, Target: target, Ability: name, Data: map[string]interface{}{"cycle": cycle}})
+	return f, nil
+}
+
+// ResolveFates merges every fate queued for the cycle according to the game's fate rules,
+// writes each target's final fate and the outcome record, and removes the resolved fates from
+// the queue. Only one instance resolves a game at a time.
+func (store *Store) ResolveFates(gameBin string, cycle int) (map[string]*FateOutcome, error) {
+
+	var outcomes map[string]*FateOutcome
+	err := store.withGameLock(gameBin, "resolution", func() error {
+		var err error
+		outcomes, err = store.resolveCycleFates(gameBin, cycle)
+		return err
+	})
+	return outcomes, err
+}
+
+func (store *Store) resolveCycleFates(gameBin string, cycle int) (map[string]*FateOutcome, error) {
+
+	var pending map[string]*PendingFate
+	if err := store.GetPath(context.Background(), "games/"+gameBin+"/pending_fates/"+cycleKey(cycle), &pending); err != nil {
+		return nil, err
+	}
+
+	rules, err := store.GetGameFateRules(gameBin)
+	if err != nil {
+		return nil, err
+	}
+
+	outcomes := resolveFates(rules, pending, cycle)
+
+	// fates are queued without the game lock, so only the fates read here leave the queue and
+	// one queued meanwhile waits for the next resolution
+	m := make(map[string]interface{})
+	for bin := range pending {
+		m["pending_fates/"+cycleKey(cycle)+"/"+bin] = nil
+	}
+	for target, o := range outcomes {
+		m["fate_outcomes/"+cycleKey(cycle)+"/"+target] = o
+		if o.Final == nil {
+			continue
+		}
+		m["gamers/"+target+"/fate"] = o.Final
+		m["cycle_fate/"+cycleKey(cycle)+"/bin"] = cycleKey(cycle)
+		m["cycle_fate/"+cycleKey(cycle)+"/gamer_fates/"+target] = o.Final
+		if o.Eliminated {
+			m["gamers/"+target+"/is_alive"] = false
+		}
+	}
+
+	if len(m) == 0 {
+		return outcomes, nil
+	}
+	if err := store.UpdateGame(gameBin, m); err != nil {
+		return nil, err
+	}
+	for target, o := range outcomes {
+		if o.Eliminated {
+			store.logAction(gameBin, &GameAction{Type: ActionDeath, Target: target, Ability: o.Final.AbilityBin, Data: map[string]interface{}{"cycle": cycle}})
+			store.logFeed(gameBin, &FeedEvent{Type: FeedEliminated, GamerIds: []string{target}, Cycle: cycle})
+		}
+	}
+	return outcomes, nil
+}
+
+func resolveFates(rules *FateRules, pending map[string]*PendingFate, cycle int) map[string]*FateOutcome {
+
+	var fates []*PendingFate
+	for _, f := range pending {
+		if f != nil {
+			fates = append(fates, f)
+		}
+	}
+	sort.SliceStable(fates, func(i, j int) bool {
+		ri, rj := rules.rank(fates[i].Ability), rules.rank(fates[j].Ability)
+		if ri != rj {
+			return ri < rj
+		}
+		return fates[i].TimeStamp < fates[j].TimeStamp
+	})
+
+	cancelled := make(map[string]bool)
+
+	// blocks go first in priority order, a blocked gamer's actions are all cancelled
+	blocked := make(map[string]bool)
+	for _, f := range fates {
+		if !contains(rules.Blocks, f.Ability) || cancelled[f.Bin] {
+			continue
+		}
+		if f.Source != "" && blocked[f.Source] {
+			cancelled[f.Bin] = true
+			continue
+		}
+		blocked[f.Target] = true
+	}
+	for _, f := range fates {
+		if f.Source != "" && blocked[f.Source] && !contains(rules.Blocks, f.Ability) {
+			cancelled[f.Bin] = true
+		}
+	}
+
+	// fates on the same target cancel each other
+	byTarget := make(map[string][]*PendingFate)
+	for _, f := range fates {
+		byTarget[f.Target] = append(byTarget[f.Target], f)
+	}
+	for _, list := range byTarget {
+		for _, f := range list {
+			if cancelled[f.Bin] {
+				continue
+			}
+			for _, g := range list {
+				if g.Bin != f.Bin && !cancelled[g.Bin] && rules.cancels(g.Ability, f.Ability) {
+					cancelled[f.Bin] = true
+					break
+				}
+			}
+		}
+	}
+
+	outcomes := make(map[string]*FateOutcome)
+	for target, list := range byTarget {
+		o := &FateOutcome{Target: target, Cycle: cycle}
+		for _, f := range list {
+			if cancelled[f.Bin] {
+				o.Cancelled = append(o.Cancelled, f)
+				continue
+			}
+			o.Applied = append(o.Applied, f)
+			if contains(rules.Lethal, f.Ability) {
+				o.Eliminated = true
+			}
+		}
+
+		// the final fate is the highest priority surviving one, lethal fates win over the rest
+		var final *PendingFate
+		for _, f := range o.Applied {
+			if final == nil || (o.Eliminated && contains(rules.Lethal, f.Ability) && !contains(rules.Lethal, final.Ability)) {
+				final = f
+			}
+		}
+		if final != nil {
+			o.Final = &models.Fate{
+				Bin:             final.Bin,
+				AbilityBin:      final.AbilityBin,
+				TimeStamp:       final.TimeStamp,
+				IsEliminated:    o.Eliminated,
+				CycleFateSet:    cycleKey(cycle),
+				CycleEliminated: o.Eliminated,
+			}
+		}
+		outcomes[target] = o
+	}
+	return outcomes
+}
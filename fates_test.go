@@ -0,0 +1,80 @@
+package v1_test
+
+import (
+	"context"
+	models "github.com/horcu/pm-models/types"
+	"github.com/horcu/pm-store/testsupport"
+	"sync"
+	"testing"
+)
+
+// fates queued while a resolution runs must be resolved by a later one, never dropped
+func TestResolveFatesKeepsFatesQueuedDuringResolution(t *testing.T) {
+
+	store := testsupport.NewStore(t)
+	g := &models.Game{
+		Bin:    "fates",
+		Status: "started",
+		Gamers: map[string]*models.Gamer{
+			"alice": {Bin: "alice", GameId: "fates", IsAlive: true},
+			"bob":   {Bin: "bob", GameId: "fates", IsAlive: true},
+		},
+	}
+	if err := store.CreateGame(g); err != nil {
+		t.Fatalf("CreateGame: %v", err)
+	}
+
+	const queued = 20
+	var mu sync.Mutex
+	var bins []string
+	var wg sync.WaitGroup
+	for i := 0; i < queued; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			source, target := "alice", "bob"
+			if i%2 == 1 {
+				source, target = "bob", "alice"
+			}
+			f, err := store.QueueFate("save", g.Bin, source, target)
+			if err != nil {
+				t.Errorf("QueueFate: %v", err)
+				return
+			}
+			mu.Lock()
+			bins = append(bins, f.Bin)
+			mu.Unlock()
+		}(i)
+	}
+
+	resolved := make(map[string]bool)
+	record := func() {
+		outcomes, err := store.ResolveFates(g.Bin, 0)
+		if err != nil {
+			t.Fatalf("ResolveFates: %v", err)
+		}
+		for _, o := range outcomes {
+			for _, f := range append(o.Applied, o.Cancelled...) {
+				resolved[f.Bin] = true
+			}
+		}
+	}
+	for i := 0; i < 5; i++ {
+		record()
+	}
+	wg.Wait()
+	record()
+
+	for _, bin := range bins {
+		if !resolved[bin] {
+			t.Errorf("fate %s was queued but never resolved", bin)
+		}
+	}
+	var pending map[string]interface{}
+	if err := store.GetPath(context.Background(), "games/"+g.Bin+"/pending_fates/c0", &pending); err != nil {
+		t.Fatalf("GetPath: %v", err)
+	}
+	if len(pending) != 0 {
+		t.Errorf("%d fates still pending after the last resolution", len(pending))
+	}
+}
@@ -0,0 +1,103 @@
+package v1
+
+import (
+	"context"
+	"firebase.google.com/go/db"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+)
+
+// feed event types the client timeline renders, others are shown with their Text
+const (
+	FeedGameStarted  = "game_started"
+	FeedCycleStarted = "cycle_started" // e.g. "Night falls"
+	FeedEliminated   = "eliminated"    // e.g. "X was eliminated"
+	FeedGameEnded    = "game_ended"
+	FeedNarration    = "narration"
+)
+
+// FeedEvent is an entry of the narration feed under games/{id}/feed
+type FeedEvent struct {
+	Seq       int64             `json:"seq"`
+	Type      string            `json:"type"`
+	Text      string            `json:"text,omitempty"`      // narration, or a catalog key when Params are set
+	Params    map[string]string `json:"params,omitempty"`    // values for the placeholders of Text
+	GamerIds  []string          `json:"gamer_ids,omitempty"` // gamers the event is about
+	Cycle     int               `json:"cycle,omitempty"`
+	StepBin   string            `json:"step_bin,omitempty"`
+	TimeStamp string            `json:"timestamp"`
+}
+
+func feedKey(seq int64) string {
+	return fmt.Sprintf("f%012d", seq)
+}
+
+// AppendGameEvent reserves the next sequence number of the game's feed and appends the event
+func (store *Store) AppendGameEvent(gameId string, e *FeedEvent) error {
+
+	if e == nil || e.Type == "" {
+		return fmt.Errorf("invalid feed event object")
+	}
+
+	var seq int64
+	err := store.TransactionPath(context.Background(), "games/"+gameId+"/feed_seq", func(t db.TransactionNode) (interface{}, error) {
+		var current int64
+		if err := t.Unmarshal(&current); err != nil {
+			return nil, err
+		}
+		seq = current + 1
+		return seq, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	e.Seq = seq
+	if e.TimeStamp == "" {
+		e.TimeStamp = strconv.FormatInt(time.Now().UnixMilli(), 10)
+	}
+	return store.SetPath(context.Background(), "games/"+gameId+"/feed/"+feedKey(seq), e)
+}
+
+// logFeed appends to the feed without failing the state change it narrates
+func (store *Store) logFeed(gameId string, e *FeedEvent) {
+	if err := store.AppendGameEvent(gameId, e); err != nil {
+		log.Printf("Error appending %s feed event for game %s: %v", e.Type, gameId, err)
+	}
+}
+
+// GetGameFeed returns up to limit events older than beforeSeq, newest first, and the cursor of the
+// next page, 0 once the start of the feed is reached. A beforeSeq of 0 reads the latest events.
+func (store *Store) GetGameFeed(gameId string, beforeSeq int64, limit int) ([]*FeedEvent, int64, error) {
+
+	if limit <= 0 {
+		limit = 50
+	}
+
+	path := "games/" + gameId + "/feed"
+	q := store.NewRef(path).OrderByKey()
+	if beforeSeq > 0 {
+		q = q.EndAt(feedKey(beforeSeq - 1))
+	}
+	nodes, err := store.GetOrderedQuery(context.Background(), path, q.LimitToLast(limit))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	events := make([]*FeedEvent, 0, len(nodes))
+	for i := len(nodes) - 1; i >= 0; i-- {
+		e := &FeedEvent{}
+		if err := nodes[i].Unmarshal(e); err != nil {
+			return nil, 0, err
+		}
+		events = append(events, e)
+	}
+
+	var next int64
+	if len(events) == limit && events[len(events)-1].Seq > 1 {
+		next = events[len(events)-1].Seq
+	}
+	return events, next, nil
+}
@@ -0,0 +1,139 @@
+// Package fixtures seeds development databases from YAML or JSON fixture files.
+package fixtures
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+	v1 "github.com/horcu/pm-store"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Fixtures is the content of a fixture file. Every record needs a bin so that
+// loading the same file twice overwrites rather than duplicates data.
+//
+//	players:
+//	  - bin: alice
+//	    user_name: alice
+//	    status: available
+//	    privacy: public
+//	abilities:
+//	  - bin: kill
+//	    name: kill
+type Fixtures struct {
+	Players    []*models.Player        `json:"players,omitempty"`
+	Groups     []*models.Group         `json:"groups,omitempty"`
+	Characters []*models.GameCharacter `json:"characters,omitempty"`
+	Abilities  []*models.Ability       `json:"abilities,omitempty"`
+	Steps      []*models.Step          `json:"steps,omitempty"`
+}
+
+// Loader writes fixtures through a store
+type Loader struct {
+	store *v1.Store
+}
+
+// NewLoader returns a Loader writing to the given store
+func NewLoader(store *v1.Store) *Loader {
+	return &Loader{store: store}
+}
+
+// ReadFixtures parses a .yaml, .yml or .json fixture file
+func ReadFixtures(path string) (*Fixtures, error) {
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		// go through json so the models' json tags apply to yaml fixtures too
+		var raw interface{}
+		if err := yaml.Unmarshal(b, &raw); err != nil {
+			return nil, fmt.Errorf("error parsing fixtures %s: %v", path, err)
+		}
+		if b, err = json.Marshal(raw); err != nil {
+			return nil, fmt.Errorf("error parsing fixtures %s: %v", path, err)
+		}
+	case ".json":
+	default:
+		return nil, fmt.Errorf("unsupported fixture file: %s", path)
+	}
+
+	f := &Fixtures{}
+	if err := json.Unmarshal(b, f); err != nil {
+		return nil, fmt.Errorf("error parsing fixtures %s: %v", path, err)
+	}
+	return f, nil
+}
+
+// LoadFixtures reads the fixture file at path and writes every record to its node
+func (l *Loader) LoadFixtures(ctx context.Context, path string) (*Fixtures, error) {
+
+	f, err := ReadFixtures(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := l.Load(ctx, f); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Load writes already parsed fixtures
+func (l *Loader) Load(ctx context.Context, f *Fixtures) error {
+
+	// catalogs first so players and groups can reference them
+	for _, a := range f.Abilities {
+		if err := l.set(ctx, "abilities", a.Bin, a); err != nil {
+			return err
+		}
+	}
+	for _, c := range f.Characters {
+		if err := l.set(ctx, "characters", c.Bin, c); err != nil {
+			return err
+		}
+	}
+	for _, s := range f.Steps {
+		if err := l.set(ctx, "steps", s.Bin, s); err != nil {
+			return err
+		}
+	}
+	// players go through CreatePlayer so their user names are claimed like any other player's
+	for _, p := range f.Players {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if p.Bin == "" {
+			return fmt.Errorf("fixture in players has no bin")
+		}
+		if err := l.store.CreatePlayer(p); err != nil {
+			return fmt.Errorf("error writing fixture players/%s: %v", p.Bin, err)
+		}
+	}
+	for _, g := range f.Groups {
+		if err := l.set(ctx, "game_groups", g.Bin, g); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *Loader) set(ctx context.Context, path string, bin string, v interface{}) error {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if bin == "" {
+		return fmt.Errorf("fixture in %s has no bin", path)
+	}
+	if err := l.store.SetPath(ctx, path+"/"+bin, v); err != nil {
+		return fmt.Errorf("error writing fixture %s/%s: %v", path, bin, err)
+	}
+	return nil
+}
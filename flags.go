@@ -0,0 +1,195 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// feature flag types
+const (
+	FlagBool       = "bool"       // on or off for everyone
+	FlagPercentage = "percentage" // on for a stable share of players
+	FlagCohort     = "cohort"     // on for the members of its cohorts
+)
+
+var flagNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_\-]{0,63}$`)
+
+// Flag is a feature flag under feature_flags/{name}. Cohorts are named player sets under
+// cohorts/{name}/{playerId}.
+type Flag struct {
+	Name       string   `json:"name"`
+	Type       string   `json:"type"`
+	Enabled    bool     `json:"enabled"`              // a disabled flag is off for everyone, whatever its type
+	Percentage int      `json:"percentage,omitempty"` // 0 to 100
+	Cohorts    []string `json:"cohorts,omitempty"`
+	UpdatedBy  string   `json:"updated_by,omitempty"`
+	UpdatedAt  string   `json:"updated_at"`
+}
+
+// Validate checks the flag's type and the settings it needs
+func (f *Flag) Validate() error {
+
+	if f == nil || !flagNamePattern.MatchString(f.Name) {
+		return fmt.Errorf("invalid flag object")
+	}
+	switch f.Type {
+	case FlagBool:
+	case FlagPercentage:
+		if f.Percentage < 0 || f.Percentage > 100 {
+			return fmt.Errorf("flag %s percentage must be between 0 and 100", f.Name)
+		}
+	case FlagCohort:
+		if len(f.Cohorts) == 0 {
+			return fmt.Errorf("cohort flag %s needs at least one cohort", f.Name)
+		}
+	default:
+		return fmt.Errorf("invalid flag type: %s", f.Type)
+	}
+	return nil
+}
+
+// flagBucket places a player in one of 100 buckets, stable per flag so rollouts of different
+// flags don't always pick the same players
+func flagBucket(flag string, playerId string) int {
+	h := fnv.New32a()
+	h.Write([]byte(flag + ":" + playerId))
+	return int(h.Sum32() % 100)
+}
+
+// flagCache holds the flags and cohorts kept fresh by WatchFlags
+type flagCache struct {
+	mu      sync.RWMutex
+	flags   map[string]*Flag
+	cohorts map[string]map[string]bool
+}
+
+// SetFlag creates or replaces a flag on behalf of an admin
+func (store *Store) SetFlag(f *Flag, actorId string) error {
+
+	if err := f.Validate(); err != nil {
+		return err
+	}
+	f.UpdatedBy = actorId
+	f.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	return store.SetPath(context.Background(), "feature_flags/"+f.Name, f)
+}
+
+// DeleteFlag removes a flag, which is then off for everyone
+func (store *Store) DeleteFlag(name string) error {
+	return store.DeletePath(context.Background(), "feature_flags/"+name)
+}
+
+// GetFlags returns every flag
+func (store *Store) GetFlags() (map[string]*Flag, error) {
+
+	var m map[string]*Flag
+	if err := store.GetPath(context.Background(), "feature_flags", &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AddToCohort adds players to a cohort
+func (store *Store) AddToCohort(cohort string, playerIds ...string) error {
+
+	m := make(map[string]interface{}, len(playerIds))
+	for _, id := range playerIds {
+		m[id] = true
+	}
+	return store.UpdatePath(context.Background(), "cohorts/"+cohort, m)
+}
+
+// RemoveFromCohort removes players from a cohort
+func (store *Store) RemoveFromCohort(cohort string, playerIds ...string) error {
+
+	m := make(map[string]interface{}, len(playerIds))
+	for _, id := range playerIds {
+		m[id] = nil
+	}
+	return store.UpdatePath(context.Background(), "cohorts/"+cohort, m)
+}
+
+// WatchFlags keeps an in-memory copy of the flags and cohorts fresh until ctx is done, IsEnabled
+// then answers from memory
+func (store *Store) WatchFlags(ctx context.Context) {
+
+	c := &flagCache{}
+	store.flags.Store(c)
+	go store.pollNode(ctx, "feature_flags", func(raw interface{}) bool {
+		var flags map[string]*Flag
+		if err := decodeRaw(raw, &flags); err != nil {
+			log.Printf("Error decoding feature flags: %v", err)
+			return false
+		}
+		c.mu.Lock()
+		c.flags = flags
+		c.mu.Unlock()
+		return true
+	})
+	go store.pollNode(ctx, "cohorts", func(raw interface{}) bool {
+		var cohorts map[string]map[string]bool
+		if err := decodeRaw(raw, &cohorts); err != nil {
+			log.Printf("Error decoding cohorts: %v", err)
+			return false
+		}
+		c.mu.Lock()
+		c.cohorts = cohorts
+		c.mu.Unlock()
+		return true
+	})
+	go func() {
+		<-ctx.Done()
+		store.flags.CompareAndSwap(c, nil)
+	}()
+}
+
+// IsEnabled reports whether a flag is on for a player. Unknown flags and read errors are off, so
+// an experimental feature stays hidden when the flags can't be read.
+func (store *Store) IsEnabled(flag string, playerId string) bool {
+
+	if c := store.flags.Load(); c != nil {
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+		return flagEnabled(c.flags[flag], playerId, func(cohort string) bool {
+			return c.cohorts[cohort][playerId]
+		})
+	}
+
+	f := &Flag{}
+	if err := store.GetPath(context.Background(), "feature_flags/"+flag, f); err != nil {
+		log.Printf("Error reading flag %s: %v", flag, err)
+		return false
+	}
+	return flagEnabled(f, playerId, func(cohort string) bool {
+		var member bool
+		if err := store.GetPath(context.Background(), "cohorts/"+cohort+"/"+playerId, &member); err != nil {
+			log.Printf("Error reading cohort %s: %v", cohort, err)
+		}
+		return member
+	})
+}
+
+func flagEnabled(f *Flag, playerId string, inCohort func(string) bool) bool {
+
+	if f == nil || !f.Enabled {
+		return false
+	}
+	switch f.Type {
+	case FlagBool:
+		return true
+	case FlagPercentage:
+		return playerId != "" && flagBucket(f.Name, playerId) < f.Percentage
+	case FlagCohort:
+		for _, cohort := range f.Cohorts {
+			if inCohort(cohort) {
+				return true
+			}
+		}
+	}
+	return false
+}
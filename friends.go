@@ -0,0 +1,131 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+	"sort"
+	"time"
+)
+
+// FriendRequest is a pending request under players/{to}/friend_requests/{from}
+type FriendRequest struct {
+	From   string `json:"from"`
+	SentAt string `json:"sent_at"`
+}
+
+// Friend is an entry of a friends list with the friend's current status
+type Friend struct {
+	PlayerId string `json:"player_id"`
+	UserName string `json:"user_name"`
+	Photo    string `json:"photo"`
+	Status   string `json:"status"` // the player's presence, e.g. available or inAGame
+	Since    string `json:"since"`
+}
+
+// SendFriendRequest asks toId to become friends, accepting straight away if toId already asked fromId
+func (store *Store) SendFriendRequest(fromId string, toId string) error {
+
+	if fromId == "" || toId == "" || fromId == toId {
+		return fmt.Errorf("invalid friend request")
+	}
+	ctx := context.Background()
+	if err := store.checkNotBlocked(fromId, []string{toId}); err != nil {
+		return err
+	}
+
+	var since string
+	if err := store.GetPath(ctx, "players/"+fromId+"/friends/"+toId+"/since", &since); err != nil {
+		return err
+	}
+	if since != "" {
+		return fmt.Errorf("players %s and %s are already friends", fromId, toId)
+	}
+
+	reverse := &FriendRequest{}
+	if err := store.GetPath(ctx, "players/"+fromId+"/friend_requests/"+toId, reverse); err != nil {
+		return err
+	}
+	if reverse.From != "" {
+		return store.AcceptFriendRequest(fromId, toId)
+	}
+
+	return store.SetPath(ctx, "players/"+toId+"/friend_requests/"+fromId, &FriendRequest{
+		From:   fromId,
+		SentAt: time.Now().UTC().Format(time.RFC3339),
+	})
+}
+
+// AcceptFriendRequest accepts the request fromId sent to playerId, adding both sides in one update
+func (store *Store) AcceptFriendRequest(playerId string, fromId string) error {
+
+	ctx := context.Background()
+	req := &FriendRequest{}
+	if err := store.GetPath(ctx, "players/"+playerId+"/friend_requests/"+fromId, req); err != nil {
+		return err
+	}
+	if req.From == "" {
+		return fmt.Errorf("no friend request from %s to %s", fromId, playerId)
+	}
+
+	since := time.Now().UTC().Format(time.RFC3339)
+	return store.UpdatePath(ctx, "/", map[string]interface{}{
+		"players/" + playerId + "/friends/" + fromId:         map[string]interface{}{"since": since},
+		"players/" + fromId + "/friends/" + playerId:         map[string]interface{}{"since": since},
+		"players/" + playerId + "/friend_requests/" + fromId: nil,
+	})
+}
+
+// DeclineFriendRequest drops the request fromId sent to playerId
+func (store *Store) DeclineFriendRequest(playerId string, fromId string) error {
+	return store.DeletePath(context.Background(), "players/"+playerId+"/friend_requests/"+fromId)
+}
+
+// RemoveFriend ends a friendship on both sides
+func (store *Store) RemoveFriend(playerId string, friendId string) error {
+
+	return store.UpdatePath(context.Background(), "/", map[string]interface{}{
+		"players/" + playerId + "/friends/" + friendId: nil,
+		"players/" + friendId + "/friends/" + playerId: nil,
+	})
+}
+
+// GetFriendRequests returns the pending requests sent to a player
+func (store *Store) GetFriendRequests(playerId string) (map[string]*FriendRequest, error) {
+
+	var m map[string]*FriendRequest
+	if err := store.GetPath(context.Background(), "players/"+playerId+"/friend_requests", &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetFriends returns a player's friends with their status, available friends first so invite
+// flows can suggest them
+func (store *Store) GetFriends(playerId string) ([]*Friend, error) {
+
+	ctx := context.Background()
+	var m map[string]struct {
+		Since string `json:"since"`
+	}
+	if err := store.GetPath(ctx, "players/"+playerId+"/friends", &m); err != nil {
+		return nil, err
+	}
+
+	friends := make([]*Friend, 0, len(m))
+	for id, f := range m {
+		p := &models.Player{}
+		if err := store.GetPath(ctx, "players/"+id, p); err != nil {
+			return nil, err
+		}
+		friends = append(friends, &Friend{PlayerId: id, UserName: p.UserName, Photo: p.Photo, Status: p.Status, Since: f.Since})
+	}
+	sort.Slice(friends, func(i, j int) bool {
+		ai, aj := friends[i].Status == "available", friends[j].Status == "available"
+		if ai != aj {
+			return ai
+		}
+		return friends[i].UserName < friends[j].UserName
+	})
+	return friends, nil
+}
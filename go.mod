@@ -9,8 +9,11 @@ require (
 )
 
 require (
+	cloud.google.com/go/pubsub v1.42.0
+	cloud.google.com/go/storage v1.44.0
 	github.com/google/uuid v1.6.0
 	github.com/horcu/pm-models v0.0.0-20241212232703-3693a7c75a8f
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -23,7 +26,6 @@ require (
 	cloud.google.com/go/iam v1.2.1 // indirect
 	cloud.google.com/go/longrunning v0.6.1 // indirect
 	cloud.google.com/go/monitoring v1.21.0 // indirect
-	cloud.google.com/go/storage v1.44.0 // indirect
 	github.com/GoogleCloudPlatform/opentelemetry-operations-go/detectors/gcp v1.24.1 // indirect
 	github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/metric v0.48.1 // indirect
 	github.com/GoogleCloudPlatform/opentelemetry-operations-go/internal/resourcemapping v0.48.1 // indirect
@@ -0,0 +1,206 @@
+package v1
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+)
+
+// DiscoverableGroup is the discovery listing of a public group, kept in sync under
+// discoverable_groups/{groupId} while the group's public flag is set
+type DiscoverableGroup struct {
+	Bin         string   `json:"bin"`
+	Name        string   `json:"name"`
+	NameKey     string   `json:"name_key"` // lowercased name and bin, lets listings filter by prefix and page by name
+	MemberCount int      `json:"member_count"`
+	Capacity    int      `json:"capacity"`
+	OpenSlots   int      `json:"open_slots"` // -1 when the group has no capacity limit
+	Tags        []string `json:"tags,omitempty"`
+	ActiveAt    string   `json:"active_at"` // the group's last write or announcement
+}
+
+// GroupDiscoveryOptions filters and pages through public groups. The listing is ordered by
+// name, which needs an .indexOn rule for name_key under discoverable_groups.
+type GroupDiscoveryOptions struct {
+	NamePrefix   string        // only list groups whose name starts with this, ignoring case
+	MinOpenSlots int           // only list groups with at least this many free slots
+	Tags         []string      // only list groups carrying every one of these tags
+	ActiveWithin time.Duration // only list groups active this recently, zero lists all
+	Limit        int           // page size, defaults to 20
+	Cursor       string        // name key of the last group of the previous page
+}
+
+// group update keys that change the discovery listing
+var discoveryKeys = []string{"public", "group_name", "capacity", "members", "status", "tags"}
+
+func groupNameKey(name string, bin string) string {
+	return strings.ToLower(name) + "|" + bin
+}
+
+// touchesDiscovery reports whether a group update changes fields shown in discovery
+func touchesDiscovery(m map[string]interface{}) bool {
+	for k := range m {
+		for _, key := range discoveryKeys {
+			if k == key || strings.HasPrefix(k, key+"/") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SetGroupPublic lists or unlists a group in discovery, only admins can change it
+func (store *Store) SetGroupPublic(groupId string, actorId string, public bool) error {
+
+	if _, err := store.requireRole(groupId, actorId, RoleAdmin); err != nil {
+		return err
+	}
+	return store.UpdateGameGroup(groupId, map[string]interface{}{"public": public})
+}
+
+// refreshGroupListing rebuilds a group's discovery listing from its listing fields, dropping it
+// when the group is gone or no longer public
+func (store *Store) refreshGroupListing(groupId string) {
+
+	ctx := context.Background()
+	var public bool
+	if err := store.GetPath(ctx, "game_groups/"+groupId+"/public", &public); err != nil {
+		log.Printf("Error refreshing discovery listing for %s: %v", groupId, err)
+		return
+	}
+	if !public {
+		store.deleteGroupListing(groupId)
+		return
+	}
+
+	var name string
+	var capacity int
+	var members map[string]interface{}
+	_ = store.GetPath(ctx, "game_groups/"+groupId+"/group_name", &name)
+	_ = store.GetPath(ctx, "game_groups/"+groupId+"/capacity", &capacity)
+	if err := store.GetShallowPath(ctx, "game_groups/"+groupId+"/members", &members); err != nil {
+		log.Printf("Error refreshing discovery listing for %s: %v", groupId, err)
+		return
+	}
+	tags, err := store.GetGroupTags(groupId)
+	if err != nil {
+		log.Printf("Error refreshing discovery listing for %s: %v", groupId, err)
+		return
+	}
+
+	open := -1
+	if capacity > 0 {
+		open = max(capacity-len(members), 0)
+	}
+	err = store.SetPath(ctx, "discoverable_groups/"+groupId, &DiscoverableGroup{
+		Bin:         groupId,
+		Name:        name,
+		NameKey:     groupNameKey(name, groupId),
+		MemberCount: len(members),
+		Capacity:    capacity,
+		OpenSlots:   open,
+		Tags:        tags,
+		ActiveAt:    FormatTime(time.Now()),
+	})
+	if err != nil {
+		log.Printf("Error refreshing discovery listing for %s: %v", groupId, err)
+	}
+}
+
+// touchGroupActivity marks a public group active without rebuilding its listing
+func (store *Store) touchGroupActivity(groupId string) {
+
+	var bin string
+	if err := store.GetPath(context.Background(), "discoverable_groups/"+groupId+"/bin", &bin); err != nil || bin == "" {
+		return
+	}
+	if err := store.SetPath(context.Background(), "discoverable_groups/"+groupId+"/active_at", FormatTime(time.Now())); err != nil {
+		log.Printf("Error touching discovery listing for %s: %v", groupId, err)
+	}
+}
+
+func (store *Store) deleteGroupListing(groupId string) {
+	if err := store.DeletePath(context.Background(), "discoverable_groups/"+groupId); err != nil {
+		log.Printf("Error deleting discovery listing for %s: %v", groupId, err)
+	}
+}
+
+// ListPublicGroups returns a page of public groups ordered by name and the cursor of the next
+// page, empty on the last page
+func (store *Store) ListPublicGroups(opts GroupDiscoveryOptions) ([]*DiscoverableGroup, string, error) {
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	prefix := strings.ToLower(opts.NamePrefix)
+	var since time.Time
+	if opts.ActiveWithin > 0 {
+		since = time.Now().Add(-opts.ActiveWithin)
+	}
+
+	ctx := context.Background()
+	cursor := opts.Cursor
+	if cursor == "" {
+		cursor = prefix
+	}
+	batch := limit + 1
+	var page []*DiscoverableGroup
+	for len(page) <= limit {
+		q := store.NewRef("discoverable_groups").OrderByChild("name_key").StartAt(cursor)
+		if prefix != "" {
+			q = q.EndAt(prefix + "\uf8ff")
+		}
+		nodes, err := store.GetOrderedQuery(ctx, "discoverable_groups", q.LimitToFirst(batch))
+		if err != nil {
+			return nil, "", err
+		}
+
+		read := 0
+		for _, n := range nodes {
+			g := &DiscoverableGroup{}
+			if err := n.Unmarshal(g); err != nil {
+				return nil, "", err
+			}
+			if g.NameKey == cursor {
+				continue
+			}
+			read++
+			cursor = g.NameKey
+			if matchesDiscovery(g, opts, since) {
+				page = append(page, g)
+				if len(page) > limit {
+					break
+				}
+			}
+		}
+		if read == 0 || len(nodes) < batch {
+			break
+		}
+	}
+
+	next := ""
+	if len(page) > limit {
+		page = page[:limit]
+		next = page[limit-1].NameKey
+	}
+	return page, next, nil
+}
+
+func matchesDiscovery(g *DiscoverableGroup, opts GroupDiscoveryOptions, since time.Time) bool {
+
+	if opts.MinOpenSlots > 0 && g.OpenSlots >= 0 && g.OpenSlots < opts.MinOpenSlots {
+		return false
+	}
+	if !hasAllTags(g.Tags, opts.Tags) {
+		return false
+	}
+	if !since.IsZero() {
+		t, err := ParseTime(g.ActiveAt)
+		if err != nil || t.Before(since) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,147 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+)
+
+// group member roles, stored as members/{playerId}/role next to the member's player fields
+const (
+	RoleOwner  = "owner"
+	RoleAdmin  = "admin"
+	RoleMember = "member"
+)
+
+var roleRank = map[string]int{RoleMember: 0, RoleAdmin: 1, RoleOwner: 2}
+
+// memberNode returns the member entry of a player with its role, and its status when its privacy
+// shows it
+func memberNode(p *models.Player, role string) map[string]interface{} {
+	m := map[string]interface{}{}
+	if b, err := json.Marshal(SummarizePlayer(p)); err == nil {
+		_ = json.Unmarshal(b, &m)
+	}
+	m["role"] = role
+	if p.Status != "" && statusVisible(p.Privacy) {
+		m["status"] = p.Status
+	}
+	return m
+}
+
+// GetMemberRole returns a member's role, empty when the player is not a member. Members added
+// before roles existed are owners when they created the group and members otherwise.
+func (store *Store) GetMemberRole(groupId string, playerId string) (string, error) {
+
+	ctx := context.Background()
+	var member map[string]interface{}
+	if err := store.GetPath(ctx, "game_groups/"+groupId+"/members/"+playerId, &member); err != nil {
+		return "", err
+	}
+	if member == nil {
+		return "", nil
+	}
+	if role, _ := member["role"].(string); role != "" {
+		return role, nil
+	}
+
+	var creator string
+	if err := store.GetPath(ctx, "game_groups/"+groupId+"/creator/bin", &creator); err != nil {
+		return "", err
+	}
+	if creator == playerId {
+		return RoleOwner, nil
+	}
+	return RoleMember, nil
+}
+
+// requireRole fails unless the actor holds at least the given role in the group
+func (store *Store) requireRole(groupId string, actorId string, role string) (string, error) {
+
+	actual, err := store.GetMemberRole(groupId, actorId)
+	if err != nil {
+		return "", err
+	}
+	if actual == "" || roleRank[actual] < roleRank[role] {
+		return "", fmt.Errorf("player %s is not a group %s of %s", actorId, role, groupId)
+	}
+	return actual, nil
+}
+
+func (store *Store) setMemberRole(groupId string, playerId string, role string) error {
+	return store.UpdateGameGroup(groupId, map[string]interface{}{"members/" + playerId + "/role": role})
+}
+
+// PromoteMember makes a member an admin, only the owner can promote
+func (store *Store) PromoteMember(groupId string, actorId string, playerId string) error {
+
+	if _, err := store.requireRole(groupId, actorId, RoleOwner); err != nil {
+		return err
+	}
+	role, err := store.GetMemberRole(groupId, playerId)
+	if err != nil {
+		return err
+	}
+	if role != RoleMember {
+		return fmt.Errorf("player %s can't be promoted from %q", playerId, role)
+	}
+	return store.setMemberRole(groupId, playerId, RoleAdmin)
+}
+
+// DemoteMember makes an admin a plain member again, only the owner can demote
+func (store *Store) DemoteMember(groupId string, actorId string, playerId string) error {
+
+	if _, err := store.requireRole(groupId, actorId, RoleOwner); err != nil {
+		return err
+	}
+	role, err := store.GetMemberRole(groupId, playerId)
+	if err != nil {
+		return err
+	}
+	if role != RoleAdmin {
+		return fmt.Errorf("player %s can't be demoted from %q", playerId, role)
+	}
+	return store.setMemberRole(groupId, playerId, RoleMember)
+}
+
+// InviteToGroupAs invites a player to a group on behalf of an admin or the owner
+func (store *Store) InviteToGroupAs(actorId string, playerId string, invitation *models.Invitation) error {
+
+	if invitation == nil || invitation.Bin == "" || invitation.GameGroup == "" {
+		return fmt.Errorf("invalid invitation object")
+	}
+	if _, err := store.requireRole(invitation.GameGroup, actorId, RoleAdmin); err != nil {
+		return err
+	}
+	if err := store.checkNotBlocked(playerId, []string{actorId}); err != nil {
+		return err
+	}
+	invitation.CreatorId = actorId
+	return store.AddInvitationToPlayer(playerId, invitation.Bin, invitation)
+}
+
+// KickMember removes a member on behalf of an admin or the owner. Admins can only kick plain
+// members and the owner can't be kicked.
+func (store *Store) KickMember(groupId string, actorId string, playerId string) error {
+
+	actorRole, err := store.requireRole(groupId, actorId, RoleAdmin)
+	if err != nil {
+		return err
+	}
+	role, err := store.GetMemberRole(groupId, playerId)
+	if err != nil {
+		return err
+	}
+	if role == "" {
+		return fmt.Errorf("player %s is not a member of %s", playerId, groupId)
+	}
+	if roleRank[role] >= roleRank[actorRole] {
+		return fmt.Errorf("player %s can't kick a group %s", actorId, role)
+	}
+	if err := store.UpdateGameGroup(groupId, map[string]interface{}{"members/" + playerId: nil}); err != nil {
+		return err
+	}
+	store.promoteGroupWaitlist(groupId)
+	return nil
+}
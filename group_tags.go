@@ -0,0 +1,143 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// play style tags, language and region tags are built with LanguageTag and RegionTag
+const (
+	TagCasual = "casual"
+	TagRanked = "ranked"
+)
+
+// maxGroupTags bounds the tags of one group
+const maxGroupTags = 10
+
+var groupTagPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_:-]{0,31}$`)
+
+// LanguageTag returns the tag of groups playing in a language, e.g. lang:en
+func LanguageTag(code string) string {
+	return "lang:" + strings.ToLower(code)
+}
+
+// RegionTag returns the tag of groups playing in a region, e.g. region:eu-west
+func RegionTag(region string) string {
+	return "region:" + strings.ToLower(region)
+}
+
+// normalizeGroupTags lowercases, dedupes and sorts tags, rejecting malformed ones and regions
+// missing from the routing table when the store has one
+func (store *Store) normalizeGroupTags(tags []string) ([]string, error) {
+
+	seen := make(map[string]bool, len(tags))
+	var out []string
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if !groupTagPattern.MatchString(t) {
+			return nil, fmt.Errorf("invalid group tag %q", t)
+		}
+		if region, ok := strings.CutPrefix(t, "region:"); ok && len(store.regions) > 0 {
+			if _, known := store.regions[region]; !known {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownRegion, region)
+			}
+		}
+		if !seen[t] {
+			seen[t] = true
+			out = append(out, t)
+		}
+	}
+	if len(out) > maxGroupTags {
+		return nil, fmt.Errorf("a group can have at most %d tags", maxGroupTags)
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// SetGroupTags replaces a group's tags and the group_tags/{tag}/{groupId} index in one update,
+// only admins can tag a group
+func (store *Store) SetGroupTags(groupId string, actorId string, tags []string) error {
+
+	if _, err := store.requireRole(groupId, actorId, RoleAdmin); err != nil {
+		return err
+	}
+	tags, err := store.normalizeGroupTags(tags)
+	if err != nil {
+		return err
+	}
+	old, err := store.GetGroupTags(groupId)
+	if err != nil {
+		return err
+	}
+
+	m := map[string]interface{}{"game_groups/" + groupId + "/tags": nil}
+	for _, t := range old {
+		m["group_tags/"+t+"/"+groupId] = nil
+	}
+	if len(tags) > 0 {
+		set := make(map[string]interface{}, len(tags))
+		for _, t := range tags {
+			set[t] = true
+			m["group_tags/"+t+"/"+groupId] = true
+		}
+		m["game_groups/"+groupId+"/tags"] = set
+	}
+	if err := store.UpdatePath(context.Background(), "/", m); err != nil {
+		return err
+	}
+	store.refreshGroupListing(groupId)
+	return nil
+}
+
+// GetGroupTags returns a group's sorted tags
+func (store *Store) GetGroupTags(groupId string) ([]string, error) {
+	return store.GetShallowKeys("game_groups/" + groupId + "/tags")
+}
+
+// SearchGroupsByTag returns the sorted ids of the groups carrying every one of the tags
+func (store *Store) SearchGroupsByTag(tags []string) ([]string, error) {
+
+	tags, err := store.normalizeGroupTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 {
+		return nil, fmt.Errorf("no tags to search for")
+	}
+
+	var ids []string
+	for i, t := range tags {
+		tagged, err := store.GetShallowKeys("group_tags/" + t)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			ids = tagged
+			continue
+		}
+		var both []string
+		for _, id := range ids {
+			if contains(tagged, id) {
+				both = append(both, id)
+			}
+		}
+		ids = both
+		if len(ids) == 0 {
+			break
+		}
+	}
+	return ids, nil
+}
+
+// hasAllTags reports whether every wanted tag is in tags
+func hasAllTags(tags []string, wanted []string) bool {
+	for _, t := range wanted {
+		if !contains(tags, strings.ToLower(t)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,150 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"firebase.google.com/go/db"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+	"log"
+	"time"
+)
+
+// GameOrphaned is the status of a started game whose server stopped sending heartbeats
+const GameOrphaned = "orphaned"
+
+var (
+	errNotStarted  = errors.New("game is not started")
+	errNotOrphaned = errors.New("game is not orphaned")
+)
+
+// serverHeartbeat is the liveness record of a game server, indexed under server_heartbeats/{gameId}
+type serverHeartbeat struct {
+	At     int64  `json:"at"` // unix milliseconds
+	Server string `json:"server,omitempty"`
+}
+
+// Heartbeat is called by the allocated game server every few seconds, it refreshes the game's
+// server info and brings an orphaned game back to started. It fails with ErrAllocationTaken
+// unless allocationId still owns the game.
+func (store *Store) Heartbeat(gameId string, allocationId string, info *models.ServerInfo) error {
+
+	ctx := context.Background()
+	if err := store.checkAllocationOwner(gameId, allocationId); err != nil {
+		return err
+	}
+	hb := &serverHeartbeat{At: time.Now().UnixMilli()}
+	m := map[string]interface{}{
+		"server_heartbeats/" + gameId: hb,
+	}
+	if info != nil {
+		hb.Server = info.Name
+		m["games/"+gameId+"/info"] = info
+	}
+	if err := store.UpdatePath(ctx, "/", m); err != nil {
+		return err
+	}
+
+	var status string
+	if err := store.GetPath(ctx, "games/"+gameId+"/status", &status); err != nil {
+		return err
+	}
+	if status != GameOrphaned {
+		return nil
+	}
+	err := store.TransactionPath(ctx, "games/"+gameId+"/status", func(t db.TransactionNode) (interface{}, error) {
+		var status string
+		if err := t.Unmarshal(&status); err != nil {
+			return nil, err
+		}
+		if status != GameOrphaned {
+			return nil, errNotOrphaned
+		}
+		return "started", nil
+	})
+	if errors.Is(err, errNotOrphaned) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	store.refreshGameSummary(gameId, nil)
+	store.publish(&DomainEvent{Type: EventGameRecovered, GameId: gameId})
+	return nil
+}
+
+// ClearHeartbeat stops watching a game's server, e.g. when the game ends
+func (store *Store) ClearHeartbeat(gameId string) error {
+	return store.DeletePath(context.Background(), "server_heartbeats/"+gameId)
+}
+
+// RunHeartbeatReaper orphans games whose server missed heartbeats for longer than timeout, every
+// interval until ctx is done
+func (store *Store) RunHeartbeatReaper(ctx context.Context, interval time.Duration, timeout time.Duration) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := store.ReapOrphanedGames(timeout); err != nil {
+			log.Printf("Error reaping orphaned games: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ReapOrphanedGames marks started games without a heartbeat within timeout as orphaned, notifies
+// and releases their players and returns their ids. Games that aren't started just stop being watched.
+func (store *Store) ReapOrphanedGames(timeout time.Duration) ([]string, error) {
+
+	ctx := context.Background()
+	horizon := time.Now().Add(-timeout).UnixMilli()
+	q := store.NewRef("server_heartbeats").OrderByChild("at").EndAt(horizon)
+	nodes, err := store.GetOrderedQuery(ctx, "server_heartbeats", q)
+	if err != nil {
+		return nil, err
+	}
+
+	var orphaned []string
+	var errs []error
+	for _, n := range nodes {
+		gameId := n.Key()
+		err := store.TransactionPath(ctx, "games/"+gameId+"/status", func(t db.TransactionNode) (interface{}, error) {
+			var status string
+			if err := t.Unmarshal(&status); err != nil {
+				return nil, err
+			}
+			if status != "started" {
+				return nil, errNotStarted
+			}
+			return GameOrphaned, nil
+		})
+		notStarted := errors.Is(err, errNotStarted)
+		if err != nil && !notStarted {
+			errs = append(errs, fmt.Errorf("error orphaning game %s: %v", gameId, err))
+			continue
+		}
+		if err := store.ClearHeartbeat(gameId); err != nil {
+			errs = append(errs, err)
+		}
+		if notStarted {
+			continue
+		}
+		orphaned = append(orphaned, gameId)
+		if err := store.markAllocationOrphaned(gameId); err != nil {
+			errs = append(errs, err)
+		}
+		var gamers map[string]*models.Gamer
+		if err := store.GetPath(ctx, "games/"+gameId+"/gamers", &gamers); err != nil {
+			errs = append(errs, err)
+		} else {
+			store.releasePlayers(&models.Game{Bin: gameId, Gamers: gamers})
+		}
+		store.refreshGameSummary(gameId, nil)
+		store.publish(&DomainEvent{Type: EventGameOrphaned, GameId: gameId})
+	}
+	return orphaned, errors.Join(errs...)
+}
@@ -0,0 +1,423 @@
+// Package http exposes the core store operations as JSON REST endpoints.
+package http
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+	v1 "github.com/horcu/pm-store"
+	"log"
+	stdhttp "net/http"
+)
+
+// Middleware wraps the handler, e.g. for authentication or logging
+type Middleware func(stdhttp.Handler) stdhttp.Handler
+
+// Authenticator resolves the caller's player id from a request
+type Authenticator func(r *stdhttp.Request) (string, error)
+
+type ctxKey int
+
+const playerKey ctxKey = iota
+
+// PlayerID returns the id the auth middleware stored for the request
+func PlayerID(ctx context.Context) string {
+	id, _ := ctx.Value(playerKey).(string)
+	return id
+}
+
+// Auth rejects requests the authenticator can't resolve and stores the player id on the request context
+func Auth(authenticate Authenticator) Middleware {
+	return func(next stdhttp.Handler) stdhttp.Handler {
+		return stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+			id, err := authenticate(r)
+			if err != nil || id == "" {
+				writeError(w, stdhttp.StatusUnauthorized, errors.New("unauthorized"))
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), playerKey, id)))
+		})
+	}
+}
+
+// ClientVersionHeader carries the version of the calling client
+const ClientVersionHeader = "X-PM-Client-Version"
+
+// RequireVersion rejects requests from clients older than the store's minimum client version with
+// 426 Upgrade Required. Requests without the header are let through.
+func RequireVersion(store *v1.Store) Middleware {
+	return func(next stdhttp.Handler) stdhttp.Handler {
+		return stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+			if version := r.Header.Get(ClientVersionHeader); version != "" {
+				err := store.CheckVersion(v1.CallerClient, version)
+				if errors.Is(err, v1.ErrOutdatedVersion) {
+					writeError(w, stdhttp.StatusUpgradeRequired, err)
+					return
+				}
+				if err != nil {
+					log.Printf("Error checking client version: %v", err)
+				}
+				r = r.WithContext(v1.WithCallerVersion(r.Context(), v1.CallerClient, version))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Handler serves the REST api for a store
+type Handler struct {
+	store      *v1.Store
+	mux        *stdhttp.ServeMux
+	middleware []Middleware
+	handler    stdhttp.Handler
+}
+
+// NewHandler returns a Handler with the store's routes registered
+func NewHandler(store *v1.Store, middleware ...Middleware) *Handler {
+	h := &Handler{
+		store:      store,
+		mux:        stdhttp.NewServeMux(),
+		middleware: middleware,
+	}
+	h.routes()
+	h.build()
+	return h
+}
+
+// Use appends middleware, the first one added is the outermost
+func (h *Handler) Use(middleware ...Middleware) {
+	h.middleware = append(h.middleware, middleware...)
+	h.build()
+}
+
+func (h *Handler) build() {
+	var handler stdhttp.Handler = h.mux
+	for i := len(h.middleware) - 1; i >= 0; i-- {
+		handler = h.middleware[i](handler)
+	}
+	h.handler = recoverer(handler)
+}
+
+func (h *Handler) ServeHTTP(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	h.handler.ServeHTTP(w, r)
+}
+
+func (h *Handler) routes() {
+	h.mux.HandleFunc("POST /games", h.createGame)
+	h.mux.HandleFunc("GET /games/{id}", h.getGame)
+	h.mux.HandleFunc("POST /games/{id}/start", h.startGame)
+	h.mux.HandleFunc("POST /games/{id}/end", h.endGame)
+	h.mux.HandleFunc("POST /games/{id}/invitations", h.invite)
+	h.mux.HandleFunc("POST /games/{id}/votes", h.vote)
+	h.mux.HandleFunc("GET /players/{id}", h.getPlayer)
+	h.mux.HandleFunc("PATCH /players/{id}/profile", h.updateProfile)
+	h.mux.HandleFunc("PUT /players/{id}/avatar", h.uploadAvatar)
+	h.mux.HandleFunc("GET /players/{id}/experiments", h.getExperiments)
+}
+
+// NewTaskHandler serves the target of the Cloud Tasks queue, POST /deferred-actions. It is kept
+// off the public api and only runs requests carrying the queue's secret, so players can't run
+// actions before they are due.
+func NewTaskHandler(store *v1.Store, secret string) stdhttp.Handler {
+
+	h := &Handler{store: store, mux: stdhttp.NewServeMux()}
+	h.mux.HandleFunc("POST /deferred-actions", func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		got := r.Header.Get(v1.TaskSecretHeader)
+		if secret == "" || subtle.ConstantTimeCompare([]byte(got), []byte(secret)) != 1 {
+			writeError(w, stdhttp.StatusUnauthorized, errors.New("unauthorized"))
+			return
+		}
+		h.runDeferredAction(w, r)
+	})
+	return recoverer(h.mux)
+}
+
+func (h *Handler) createGame(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+
+	g := &models.Game{}
+	if !readJSON(w, r, g) {
+		return
+	}
+	if g.Bin == "" {
+		writeError(w, stdhttp.StatusBadRequest, errors.New("game bin is required"))
+		return
+	}
+	// the caller hosts the game, whatever creator the body names
+	id := PlayerID(r.Context())
+	if id == "" {
+		writeError(w, stdhttp.StatusUnauthorized, errors.New("unauthorized"))
+		return
+	}
+	p, err := h.store.GetByBin(id, "players")
+	if err != nil {
+		writeError(w, stdhttp.StatusInternalServerError, err)
+		return
+	}
+	if p.(*models.Player).Bin == "" {
+		writeError(w, stdhttp.StatusForbidden, fmt.Errorf("player not found: %s", id))
+		return
+	}
+	g.Creator = p.(*models.Player)
+	if err := h.store.Create(g, "games"); err != nil {
+		status := stdhttp.StatusInternalServerError
+		if errors.Is(err, v1.ErrGameExists) {
+			status = stdhttp.StatusConflict
+		}
+		writeError(w, status, err)
+		return
+	}
+	writeJSON(w, stdhttp.StatusCreated, g)
+}
+
+func (h *Handler) getGame(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+
+	g, err := h.store.GetByBin(r.PathValue("id"), "games")
+	if err != nil {
+		writeError(w, stdhttp.StatusInternalServerError, err)
+		return
+	}
+	if g.(*models.Game).Bin == "" {
+		writeError(w, stdhttp.StatusNotFound, fmt.Errorf("game not found: %s", r.PathValue("id")))
+		return
+	}
+	// gamers only see their own character and results while the game runs
+	writeJSON(w, stdhttp.StatusOK, v1.RedactGame(g.(*models.Game), PlayerID(r.Context())))
+}
+
+// requireHost rejects callers who aren't the host of the game in the path
+func (h *Handler) requireHost(w stdhttp.ResponseWriter, r *stdhttp.Request) bool {
+
+	var host string
+	if err := h.store.GetPath(r.Context(), "games/"+r.PathValue("id")+"/creator/bin", &host); err != nil {
+		writeError(w, stdhttp.StatusInternalServerError, err)
+		return false
+	}
+	if host == "" || host != PlayerID(r.Context()) {
+		writeError(w, stdhttp.StatusForbidden, errors.New("only the host can manage the game"))
+		return false
+	}
+	return true
+}
+
+// requireSelf rejects callers who aren't the player in the path
+func (h *Handler) requireSelf(w stdhttp.ResponseWriter, r *stdhttp.Request, action string) bool {
+
+	id := PlayerID(r.Context())
+	if id == "" {
+		writeError(w, stdhttp.StatusUnauthorized, errors.New("unauthorized"))
+		return false
+	}
+	if id != r.PathValue("id") {
+		writeError(w, stdhttp.StatusForbidden, fmt.Errorf("players can only %s", action))
+		return false
+	}
+	return true
+}
+
+func (h *Handler) getPlayer(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+
+	p, err := h.store.GetByBin(r.PathValue("id"), "players")
+	if err != nil {
+		writeError(w, stdhttp.StatusInternalServerError, err)
+		return
+	}
+	if p.(*models.Player).Bin == "" {
+		writeError(w, stdhttp.StatusNotFound, fmt.Errorf("player not found: %s", r.PathValue("id")))
+		return
+	}
+	// other players only see the public profile
+	if PlayerID(r.Context()) != r.PathValue("id") {
+		p = v1.PublicPlayer(p.(*models.Player))
+	}
+	writeJSON(w, stdhttp.StatusOK, p)
+}
+
+func (h *Handler) updateProfile(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+
+	if !h.requireSelf(w, r, "update their own profile") {
+		return
+	}
+	patch := v1.ProfilePatch{}
+	if !readJSON(w, r, &patch) {
+		return
+	}
+	if err := patch.Validate(); err != nil {
+		writeError(w, stdhttp.StatusBadRequest, err)
+		return
+	}
+	if err := h.store.UpdatePlayerProfile(r.PathValue("id"), patch); err != nil {
+		status := stdhttp.StatusInternalServerError
+		if errors.Is(err, v1.ErrUserNameTaken) {
+			status = stdhttp.StatusConflict
+		}
+		writeError(w, status, err)
+		return
+	}
+	w.WriteHeader(stdhttp.StatusNoContent)
+}
+
+func (h *Handler) uploadAvatar(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+
+	if !h.requireSelf(w, r, "update their own avatar") {
+		return
+	}
+	url, err := h.store.UploadAvatar(r.PathValue("id"), r.Body, r.Header.Get("Content-Type"))
+	if err != nil {
+		status := stdhttp.StatusInternalServerError
+		if errors.Is(err, v1.ErrAvatarTooLarge) {
+			status = stdhttp.StatusRequestEntityTooLarge
+		} else if errors.Is(err, v1.ErrUnsupportedAvatar) {
+			status = stdhttp.StatusUnsupportedMediaType
+		}
+		writeError(w, status, err)
+		return
+	}
+	writeJSON(w, stdhttp.StatusOK, map[string]string{"photo": url})
+}
+
+func (h *Handler) getExperiments(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+
+	if !h.requireSelf(w, r, "read their own experiments") {
+		return
+	}
+	assignments, err := h.store.GetAssignments(r.PathValue("id"))
+	if err != nil {
+		writeError(w, stdhttp.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, stdhttp.StatusOK, assignments)
+}
+
+func (h *Handler) startGame(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+
+	if !h.requireHost(w, r) {
+		return
+	}
+	if _, err := h.store.StartGame(r.PathValue("id")); err != nil {
+		writeError(w, stdhttp.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(stdhttp.StatusNoContent)
+}
+
+func (h *Handler) endGame(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+
+	if !h.requireHost(w, r) {
+		return
+	}
+	if _, err := h.store.EndGame(r.PathValue("id")); err != nil {
+		writeError(w, stdhttp.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(stdhttp.StatusNoContent)
+}
+
+type inviteRequest struct {
+	PlayerId   string             `json:"player_id"`
+	Invitation *models.Invitation `json:"invitation"`
+}
+
+func (h *Handler) invite(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+
+	if !h.requireHost(w, r) {
+		return
+	}
+	req := &inviteRequest{}
+	if !readJSON(w, r, req) {
+		return
+	}
+	if req.PlayerId == "" || req.Invitation == nil || req.Invitation.Bin == "" {
+		writeError(w, stdhttp.StatusBadRequest, errors.New("player_id and invitation.bin are required"))
+		return
+	}
+	req.Invitation.GameId = r.PathValue("id")
+	if _, err := h.store.InvitePlayerToGame(req.PlayerId, *req.Invitation); err != nil {
+		writeError(w, stdhttp.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, stdhttp.StatusCreated, req.Invitation)
+}
+
+func (h *Handler) vote(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+
+	vote := &models.Vote{}
+	if !readJSON(w, r, vote) {
+		return
+	}
+	vote.GameBin = r.PathValue("id")
+	if id := PlayerID(r.Context()); id != "" {
+		vote.Source = id
+	}
+	if vote.Source == "" {
+		writeError(w, stdhttp.StatusBadRequest, errors.New("vote source is required"))
+		return
+	}
+	if !h.store.Vote(vote) {
+		writeError(w, stdhttp.StatusUnprocessableEntity, errors.New("vote was not accepted"))
+		return
+	}
+	writeJSON(w, stdhttp.StatusCreated, vote)
+}
+
+type deferredActionRequest struct {
+	Bin string `json:"bin"`
+}
+
+// runDeferredAction is the target of the Cloud Tasks queue
+func (h *Handler) runDeferredAction(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+
+	req := &deferredActionRequest{}
+	if !readJSON(w, r, req) {
+		return
+	}
+	if req.Bin == "" {
+		writeError(w, stdhttp.StatusBadRequest, errors.New("bin is required"))
+		return
+	}
+	if err := h.store.RunDeferredAction(req.Bin); err != nil {
+		writeError(w, stdhttp.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(stdhttp.StatusNoContent)
+}
+
+func readJSON(w stdhttp.ResponseWriter, r *stdhttp.Request, v interface{}) bool {
+	dec := json.NewDecoder(stdhttp.MaxBytesReader(w, r.Body, 1<<20))
+	if err := dec.Decode(v); err != nil {
+		writeError(w, stdhttp.StatusBadRequest, fmt.Errorf("invalid request body: %v", err))
+		return false
+	}
+	return true
+}
+
+func writeJSON(w stdhttp.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("Error writing response: %v", err)
+	}
+}
+
+func writeError(w stdhttp.ResponseWriter, status int, err error) {
+	// writes refused during maintenance are worth retrying later
+	if errors.Is(err, v1.ErrMaintenance) {
+		status = stdhttp.StatusServiceUnavailable
+	}
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+// recoverer turns a panic in a handler into a 500 instead of taking down the service
+func recoverer(next stdhttp.Handler) stdhttp.Handler {
+	return stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		defer func() {
+			if p := recover(); p != nil {
+				log.Printf("panic serving %s %s: %v", r.Method, r.URL.Path, p)
+				writeError(w, stdhttp.StatusInternalServerError, errors.New("internal error"))
+			}
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,368 @@
+package v1
+
+import (
+	"context"
+	"firebase.google.com/go/db"
+	"firebase.google.com/go/messaging"
+	models "github.com/horcu/pm-models/types"
+	"io"
+	"math/rand"
+	"time"
+)
+
+//go:generate moq -out mocks/player_store.go -pkg mocks . PlayerStore
+//go:generate moq -out mocks/game_store.go -pkg mocks . GameStore
+//go:generate moq -out mocks/group_store.go -pkg mocks . GroupStore
+//go:generate moq -out mocks/data_store.go -pkg mocks . DataStore
+
+// PlayerStore is the part of the data layer about players, their friends, sessions, devices and notifications
+type PlayerStore interface {
+	AcceptFriendRequest(playerId string, fromId string) error
+	AcceptGameInvitation(playerId string, invitation *models.Invitation) (bool, error)
+	AddInvitationToPlayer(playerId string, bin string, m *models.Invitation) error
+	AddRandomUsers(userNames []string, photoUrls []string) ([]*models.Player, error)
+	AdjustRatings(deltas map[string]int) error
+	AssignExperiment(playerId string, experiment string) (*ExperimentAssignment, error)
+	BlockPlayer(blockerId string, blockedId string) error
+	CommendPlayer(gameId string, fromId string, toId string, category string) error
+	CountPlayers() (int, error)
+	CreateNotification(playerId string, n *Notification) error
+	CreatePlayer(b *models.Player) error
+	CreateSession(playerId string, device string) (string, *Session, error)
+	DeclineFriendRequest(playerId string, fromId string) error
+	DeclineGameInvitation(playerId string, invitation *models.Invitation) (bool, error)
+	DeleteNotification(playerId string, bin string) error
+	DeletePlayer(b interface{}) error
+	GenerateRandomUsers(rng *rand.Rand, o RandomUserOptions) ([]*models.Player, error)
+	GetActiveSessions(playerId string) (map[string]*Session, error)
+	GetAllPlayers(opts ...ListOptions) ([]*models.Player, error)
+	GetAssignments(playerId string) (map[string]*ExperimentAssignment, error)
+	GetBlockedPlayers(playerId string) ([]string, error)
+	GetFriendRequests(playerId string) (map[string]*FriendRequest, error)
+	GetFriends(playerId string) ([]*Friend, error)
+	GetKarma(playerId string) (*Karma, error)
+	GetMatchHistory(playerId string) ([]*MatchSummary, error)
+	GetNotifications(playerId string, before string, limit int) ([]*Notification, error)
+	GetPlayerDevices(playerId string) (map[string]*DeviceToken, error)
+	GetPlayerNotes(ownerId string) (map[string]*PlayerNote, error)
+	GetPlayerRating(playerId string) (int, error)
+	GetPlayerTimezone(playerId string) (string, error)
+	GetPlayerToken(bin string) (*string, error)
+	GetPlayerTokens(playerId string) ([]string, error)
+	IsBlocked(a string, b string) (bool, error)
+	ListPlayersIter(ctx context.Context) *PlayerIterator
+	MarkAllNotificationsRead(playerId string) error
+	MarkNotificationRead(playerId string, bin string) error
+	ParsePlayer(g interface{}, path string) *models.Player
+	PropagatePlayerSummary(playerId string) error
+	PruneDeviceTokens(playerId string, rejected []string) error
+	RegisterDeviceToken(playerId string, d *DeviceToken) error
+	RemoveFriend(playerId string, friendId string) error
+	RevokeOtherSessions(playerId string, keep string) error
+	RevokeSession(playerId string, id string) error
+	SendFriendRequest(fromId string, toId string) error
+	SendToPlayerDevices(ctx context.Context, playerId string, msg *messaging.MulticastMessage) (*messaging.BatchResponse, error)
+	SetPlayerNote(ownerId string, aboutPlayerId string, text string) error
+	SetPlayerStatus(playerId string, status string, gameId string) (string, error)
+	SetPlayerTimezone(playerId string, tz string) error
+	SetPresence(playerId string, p *Presence) error
+	TrackEvent(playerId string, event string, properties map[string]interface{}) error
+	UnblockPlayer(blockerId string, blockedId string) error
+	UnreadNotificationCount(playerId string) (int, error)
+	UnregisterDeviceToken(playerId string, deviceId string) error
+	UpdateInvitation(pId string, inviteId string, m map[string]interface{}) interface{}
+	UpdatePlayer(b string, m map[string]interface{}) error
+	UpdatePlayerAtRevision(b string, expected int64, m map[string]interface{}) error
+	UpdatePlayerProfile(playerId string, patch ProfilePatch) error
+	UploadAvatar(playerId string, r io.Reader, contentType string) (string, error)
+	ValidateSession(playerId string, token string) (*Session, error)
+	WatchPlayerInvitations(ctx context.Context, playerId string) <-chan *InvitationEvent
+}
+
+// GameStore is the part of the data layer about games, their gamers, steps, votes and matchmaking
+type GameStore interface {
+	AddAbilitiesToGame(gameId string, abilities map[string]*models.Ability) error
+	AddAllCharactersToGame(gameId string, chars map[string]*models.GameCharacter) error
+	AddBotToGame(gameId string, profile *BotProfile) (*models.Gamer, error)
+	AddCustomCharacterToGame(gameId string, ownerId string, bin string) error
+	AddInvitationToGame(gameId string, m map[string]interface{}) error
+	AddMessageToGame(msg *models.Message, gameId string) error
+	AddSpectator(gameId string, playerId string) error
+	AddSpectatorMessage(gameId string, msg *models.Message) error
+	AddStepToGame(step *models.Step, id string) error
+	AddStepsToGame(steps map[string]*models.Step, gameId string) map[string]*models.Step
+	AddTeamMessage(gameId string, teamId string, msg *models.Message) error
+	AddToGame(path string, bin string, c *models.GameCharacter) error
+	AdvanceCycle(gameId string) (int, error)
+	AllReady(gameId string) (bool, []string, error)
+	AppendGameAction(gameId string, a *GameAction) error
+	AppendGameEvent(gameId string, e *FeedEvent) error
+	ApplyAbility(abilityBin string, gameBin string, targetGamer string)
+	ApplyAbilityFrom(abilityBin string, gameBin string, sourceGamer string, targetGamer string)
+	ArchiveGame(gameId string) (*ArchivedGame, error)
+	ArchiveGamesOlderThan(age time.Duration) ([]string, error)
+	ArchiveStepResults(gameId string) (*StepResultsArchive, error)
+	AssignGameShard(gameId string, url string) error
+	BanFromGame(gameId string, playerId string) error
+	BridgeSpectatorChat(gameId string, hostId string) error
+	ClaimAllocation(gameId string, a *Allocation) error
+	ClearCountdown(gameId string, name string) error
+	ClearHeartbeat(gameId string) error
+	CountGames(status string) (int, error)
+	CreateCustomCharacter(ownerId string, character *models.GameCharacter) (*CustomCharacter, error)
+	CreateGame(b *models.Game) error
+	CreateGameInRegion(g *models.Game, region string) error
+	CreateGameTemplate(t *GameTemplate) error
+	CreatePrivateGame(g *models.Game) (string, error)
+	DeleteGame(b interface{}) error
+	DeleteGameTemplate(bin string) error
+	EndGame(gameId string) (bool, error)
+	EnqueueForMatch(playerId string, region string) error
+	EvaluateTeamWin(gameId string) (string, bool, error)
+	ExportGameChat(gameId string, requesterId string, w io.Writer) error
+	ExportReplay(gameId string, w io.Writer) error
+	FindAndJoinGame(playerId string, prefs MatchPrefs) (string, bool, *models.Gamer, error)
+	GameRegion(gameId string) (string, error)
+	GameShard(gameId string) (string, error)
+	GenerateInviteLink(invitationId string) (string, error)
+	GetActionsByGamer(gameId string, gamerId string) ([]*GamerAction, error)
+	GetAFKGamers(gameId string) ([]string, error)
+	GetArchivedGame(gameId string) (*ArchivedGame, error)
+	GetBotView(gameId string, botId string) (*BotView, error)
+	GetCountdown(gameId string, name string) (*Countdown, error)
+	GetCurrentCycle(gameId string) (int, error)
+	GetCustomCharacter(ownerId string, bin string) (*CustomCharacter, error)
+	GetCustomCharacters(ownerId string) (map[string]*CustomCharacter, error)
+	GetCycleResults(gameId string, cycle int) (map[string]map[string][]*models.Result, error)
+	GetGameActions(gameId string, afterSeq int64) ([]*GameAction, error)
+	GetGameBots(gameId string) (map[string]*BotProfile, error)
+	GetGameFateRules(gameId string) (*FateRules, error)
+	GetGameFeed(gameId string, beforeSeq int64, limit int) ([]*FeedEvent, int64, error)
+	GetGameIdByCode(code string) (string, error)
+	GetGameResults(gameId string) (*GameResults, error)
+	GetGameRuleset(gameId string) (*Ruleset, error)
+	GetGameSummary(gameId string) (*GameSummary, error)
+	GetGameTeams(gameId string) (map[string]*Team, error)
+	GetGameTemplate(bin string) (*GameTemplate, error)
+	GetGameTimezone(gameId string) (string, error)
+	GetGameWaitlist(gameId string) ([]*WaitlistEntry, error)
+	GetGamerByBin(b string, gId string) (*models.Gamer, error)
+	GetGamerTeam(gameId string, gamerId string) (*Team, error)
+	GetMatchResult(playerId string) (*MatchResult, error)
+	GetMatchmakingConfig() (*MatchmakingConfig, error)
+	GetSpectatorMessages(gameId string, readerId string) (map[string]*models.Message, error)
+	GetSpectators(gameId string) (map[string]*Spectator, error)
+	GetStepForLocale(gameId string, stepId string, locale string) (*models.Step, error)
+	GetStepsByGameId(gameId string, opts ...ListOptions) ([]*models.Step, error)
+	GetTeamMessages(gameId string, teamId string) (map[string]*models.Message, error)
+	Heartbeat(gameId string, allocationId string, info *models.ServerInfo) error
+	IncrementGameCounter(game *models.Game, val int) error
+	InitializeGame(game *models.Game)
+	InstantiateGameFromTemplate(templateId string, creator *models.Player, groupId string) (*models.Game, error)
+	InvitePlayerToGame(playerId string, invitation models.Invitation) (bool, error)
+	GetVoteBreakdown(gameId string, stepId string) (*VoteBreakdown, error)
+	GetCycleVoteBreakdown(gameId string, cycle int, stepId string) (*VoteBreakdown, error)
+	GetVoteHistory(gameId string, gamerId string) (*VoteHistory, error)
+	JoinGame(playerId string, gameId string) (*models.Gamer, error)
+	JoinGameByCode(playerId string, code string) (*models.Gamer, error)
+	LeaveGameWaitlist(gameId string, playerId string) error
+	LeaveMatchQueue(playerId string) error
+	ListGameSummaries(opts SummaryListOptions) ([]*GameSummary, string, error)
+	LookupAllocation(gameId string) (*Allocation, error)
+	MatchQueue(now time.Time) ([]string, error)
+	MoveGameToColdStorage(gameId string) (*ColdGame, error)
+	ParseCurrentStep(pMap interface{}, path string) *models.Step
+	QueueFate(abilityBin string, gameBin string, source string, target string) (*PendingFate, error)
+	ReapOrphanedGames(timeout time.Duration) ([]string, error)
+	ReleaseAllocation(gameId string, allocationId string) error
+	RemoveBotFromGame(gameId string, botId string) error
+	RemoveSpectator(gameId string, playerId string) error
+	ReportPlayer(gameId string, reporterId string, reportedId string, reason string) (*Report, error)
+	ResetFirstDayAndExplanationFlag(bin string) error
+	ResolveCurrentCycle(gameId string) (int, map[string]*FateOutcome, error)
+	ResolveFates(gameBin string, cycle int) (map[string]*FateOutcome, error)
+	ResolveInviteLink(token string) (*models.Invitation, *models.Game, error)
+	ReviewCustomCharacter(ownerId string, bin string, approved bool, reviewerId string, note string) error
+	RevokeInviteLink(token string) error
+	SendEmote(gameId string, gamerId string, emoteId string) (*models.Message, error)
+	SetCountdown(gameId string, name string, duration time.Duration) (*Countdown, error)
+	SetGameAllowsCustomCharacters(gameId string, allow bool) error
+	SetGameFateRules(gameId string, r *FateRules) error
+	SetGameFirstStep(bin string, step string) error
+	SetGameRuleset(gameId string, r *Ruleset) error
+	SetGameStartAndEndTimes(gameId string, startTime string, endTime string) error
+	SetGameTeams(gameId string, teams []*Team) error
+	SetGameTimes(gameId string, start time.Time, end time.Time) error
+	SetGameTimezone(gameId string, tz string) error
+	SetMatchmakingConfig(c *MatchmakingConfig) error
+	SetNewStep(gameId string)
+	SetNextStep(gameId string, fromStep string)
+	SetReady(gameId string, gamerId string, ready bool) error
+	StartGame(gameId string) (bool, error)
+	UpdateGame(b string, m map[string]interface{}) error
+	UpdateGameAtRevision(b string, expected int64, m map[string]interface{}) error
+	UpdateGamer(gameId string, gx map[string]interface{}) bool
+	UpdateGamerAbilities(gameId string, gamerId string, abBin string, ab *models.Ability) bool
+	UpdateGamersInGame(b string, m map[string]interface{}) error
+	UpdateVoteStep(gameBin string, stepBin string, updateStep map[string]interface{}) error
+	Vote(vote *models.Vote) bool
+	WaitAllReady(ctx context.Context, gameId string) ([]string, error)
+	WatchCountdown(ctx context.Context, gameId string, name string) <-chan *Countdown
+	WatchCurrentStep(ctx context.Context, gameId string) <-chan *models.Step
+}
+
+// GroupStore is the part of the data layer about groups, their members, announcements and schedules
+type GroupStore interface {
+	AcceptGroupInvitation(p *models.Player, invitationId string, groupId string) (bool, error)
+	AddPlayerToGroup(playerId string, groupId string)
+	AddPlayerToGroupMembers(gId string, bin string, m *models.Player) error
+	ApproveJoinRequest(groupId string, actorId string, playerId string) error
+	CancelScheduledGame(scheduleId string, actorId string) error
+	CountGroupMembers(groupId string) (int, error)
+	CreateGameGroup(groupName string, cap int, ownerId string, userIds []string) (bool, error)
+	DeclineGameGroupInvitation(p *models.Player, invitationId string, groupId string)
+	DeleteGameGroup(b interface{}) error
+	DeleteGroupAnnouncement(groupId string, actorId string, bin string) error
+	DemoteMember(groupId string, actorId string, playerId string) error
+	EditGroupAnnouncement(groupId string, editorId string, bin string, text string) error
+	GetGameGroupInvitations(groupId string) ([]*models.Invitation, error)
+	GetGameGroupMembers(groupId string, opts ...ListOptions) ([]*models.Player, error)
+	GetGroupAnnouncements(groupId string, before string, limit int) ([]*Announcement, string, error)
+	GetGroupRecurrences(groupId string) (map[string]*Recurrence, error)
+	GetGroupSchedules(groupId string) ([]*ScheduledGame, error)
+	GetGroupTags(groupId string) ([]string, error)
+	GetGroupWaitlist(groupId string) ([]*WaitlistEntry, error)
+	GetJoinRequests(groupId string, actorId string) (map[string]*JoinRequest, error)
+	GetMemberRole(groupId string, playerId string) (string, error)
+	GetPinnedAnnouncements(groupId string) ([]*Announcement, error)
+	GetScheduledGame(bin string) (*ScheduledGame, error)
+	InvitePlayerToGroup(playerId string, invitation *models.Invitation)
+	InviteToGroupAs(actorId string, playerId string, invitation *models.Invitation) error
+	KickMember(groupId string, actorId string, playerId string) error
+	LeaveGroupWaitlist(groupId string, playerId string) error
+	ListPublicGroups(opts GroupDiscoveryOptions) ([]*DiscoverableGroup, string, error)
+	ParseGroup(pMap interface{}, path string) *models.Group
+	PinGroupAnnouncement(groupId string, actorId string, bin string, pinned bool) error
+	PostGroupAnnouncement(groupId string, authorId string, text string) (*Announcement, error)
+	PromoteMember(groupId string, actorId string, playerId string) error
+	RSVP(scheduleId string, playerId string, answer string) error
+	RejectJoinRequest(groupId string, actorId string, playerId string) error
+	RemovePlayerFromGroup(playerId string, groupId string)
+	RequestToJoinGroup(playerId string, groupId string) error
+	ScheduleGame(groupId string, creatorId string, startAt time.Time, settings *ScheduleSettings) (*ScheduledGame, error)
+	SearchGroupsByTag(tags []string) ([]string, error)
+	SetGroupPublic(groupId string, actorId string, public bool) error
+	SetGroupRecurrence(groupId string, r *Recurrence) (*ScheduledGame, error)
+	SetGroupTags(groupId string, actorId string, tags []string) error
+	StopGroupRecurrence(groupId string, actorId string, bin string) error
+	UpdateGameGroup(b string, m map[string]interface{}) error
+	UpdateGameGroupAtRevision(b string, expected int64, m map[string]interface{}) error
+	WatchGroupMembers(ctx context.Context, groupId string) <-chan *MemberEvent
+}
+
+// DataStore is every public method of Store, for services that mock the whole data layer
+type DataStore interface {
+	PlayerStore
+	GameStore
+	GroupStore
+
+	AcquireLock(path string, ttl time.Duration) (*Lock, error)
+	ActivateBalanceConfig(version int) error
+	AddAbilitiesToDb(abilities map[string]*models.Ability) error
+	AddAbilitiesToDbContext(ctx context.Context, abilities map[string]*models.Ability) error
+	AddAllCharactersToDb(chars map[string]*models.GameCharacter) error
+	AddAllCharactersToDbContext(ctx context.Context, chars map[string]*models.GameCharacter) error
+	AddAllStepsToDb(chars map[string]*models.Step) error
+	AddAllStepsToDbContext(ctx context.Context, steps map[string]*models.Step) error
+	AddToCohort(cohort string, playerIds ...string) error
+	CancelAction(bin string) error
+	CheckVersion(kind string, version string) error
+	CloseJournal() error
+	ConcurrencyStats() ConcurrencyStats
+	Connect(firebaseURL string, firebaseAPIKey string, projectID string) error
+	ConnectEmulator(host string, namespace string) error
+	Create(b interface{}, path string) error
+	CreateAbility(ability *models.Ability) error
+	CreateCharacter(character *models.GameCharacter) error
+	CreateStep(b *models.Step) error
+	Delete(b interface{}, dataType string) error
+	DeleteEmote(id string) error
+	DeleteFlag(name string) error
+	DeletePath(ctx context.Context, path string) error
+	DeleteWebhook(bin string) error
+	DisableChangeFeed()
+	EnableChangeFeed(actor string)
+	ExportTree(path string, w io.Writer) error
+	FlushAnalytics()
+	FlushCoalesced()
+	GetAbilitiesForCharacter(characterId string) ([]*models.Ability, error)
+	GetActiveBalanceConfig() (*BalanceConfig, error)
+	GetBalanceConfig(version int) (*BalanceConfig, error)
+	GetByBin(b string, dataType string) (interface{}, error)
+	GetByBins(dataType string, bins []string) (map[string]interface{}, map[string]error)
+	GetCatalog(locale string) (map[string]string, error)
+	GetCharacterByBin(id string) (*models.GameCharacter, error)
+	GetDeferredAction(bin string) (*DeferredAction, error)
+	GetEmoteCatalog() (map[string]*Emote, error)
+	GetExperiment(name string) (*Experiment, error)
+	GetFlags() (map[string]*Flag, error)
+	GetMaintenance() (Maintenance, error)
+	GetMinVersions() (MinVersions, error)
+	GetOrderedQuery(ctx context.Context, path string, q *db.Query) ([]db.QueryNode, error)
+	GetPath(ctx context.Context, path string, v interface{}) error
+	GetQuery(ctx context.Context, path string, q *db.Query, v interface{}) error
+	GetRemoteConfig() (*RemoteConfig, error)
+	GetRevision(dataType string, bin string) (int64, error)
+	GetShallowKeys(path string) ([]string, error)
+	GetShallowPath(ctx context.Context, path string, v interface{}) error
+	GetStepByBin(step string) (*models.Step, error)
+	GetWebhooks() (map[string]*Webhook, error)
+	GetWithETag(path string, v interface{}) (string, error)
+	ImportTree(path string, r io.Reader) error
+	IsEnabled(flag string, playerId string) bool
+	JournalPending() int
+	LocalizeMessage(msg *models.Message, locale string) (*models.Message, error)
+	MigrateRecords(dataType string) (int, error)
+	NewRef(path string) *db.Ref
+	OnRemoteConfigChange(fn func(old *RemoteConfig, next *RemoteConfig))
+	OpenJournal(path string) error
+	ParseInvitationList(pMap map[string]interface{}, path string) ([]*models.Invitation, error)
+	ParsePlayerList(pMap map[string]interface{}, path string) map[string]*models.Player
+	ProcessDueActions(now time.Time) (int, error)
+	ProcessDueSchedules(now time.Time) error
+	PublishBalanceConfig(c *BalanceConfig, activate bool) (int, error)
+	PushPath(ctx context.Context, path string, v interface{}) (*db.Ref, error)
+	ReadChangesSince(cursor int64, limit int) ([]*Change, int64, error)
+	RegisterWebhook(w *Webhook) error
+	RemoveFromCohort(cohort string, playerIds ...string) error
+	ReplayJournal(ctx context.Context) (int, error)
+	RootPrefix() string
+	RunDeferredAction(bin string) error
+	RunDeferredActions(ctx context.Context, interval time.Duration)
+	RunHeartbeatReaper(ctx context.Context, interval time.Duration, timeout time.Duration)
+	RunMatchmaker(ctx context.Context, interval time.Duration)
+	RunScheduler(ctx context.Context, interval time.Duration)
+	ScheduleAction(at time.Time, a *DeferredAction) (*DeferredAction, error)
+	ServerNow() (time.Time, error)
+	ServerTimeOffset() (time.Duration, error)
+	SetCatalogEntries(locale string, entries map[string]string) error
+	SetEmote(e *Emote) error
+	SetEventPublisher(p EventPublisher)
+	SetExperiment(e *Experiment) error
+	SetFlag(f *Flag, actorId string) error
+	SetMaintenance(enabled bool, message string, actorId string) error
+	SetMinVersions(m MinVersions) error
+	SetPath(ctx context.Context, path string, v interface{}) error
+	SetRemoteConfig(c *RemoteConfig, actorId string) error
+	TransactionPath(ctx context.Context, path string, fn db.UpdateFn) error
+	Translate(key string, locale string) (string, error)
+	TrimChanges(seq int64) error
+	Update(b string, m map[string]interface{}, path string) error
+	UpdateIfMatch(path string, etag string, m map[string]interface{}) error
+	UpdatePath(ctx context.Context, path string, m map[string]interface{}) error
+	WatchFlags(ctx context.Context)
+	WatchMaintenance(ctx context.Context) <-chan Maintenance
+	WatchRemoteConfig(ctx context.Context)
+}
+
+var _ DataStore = (*Store)(nil)
@@ -0,0 +1,130 @@
+package v1
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultInviteLinkTTL is how long invite links stay valid
+const DefaultInviteLinkTTL = 7 * 24 * time.Hour
+
+// InviteLink is the record behind a share-to-join token, stored under invite_links/{id}
+type InviteLink struct {
+	Id           string `json:"id"`
+	InvitationId string `json:"invitation_id"`
+	PlayerId     string `json:"player_id"` // owner of the invitation
+	GameId       string `json:"game_id,omitempty"`
+	GroupId      string `json:"group_id,omitempty"`
+	CreatedAt    string `json:"created_at"`
+	ExpiresAt    int64  `json:"expires_at"` // unix seconds
+}
+
+// WithInviteSecret sets the key invite link tokens are signed with
+func WithInviteSecret(secret []byte) StoreOption {
+	return func(store *Store) {
+		store.inviteSecret = secret
+	}
+}
+
+func (store *Store) signInvite(id string, expires int64) string {
+	mac := hmac.New(sha256.New, store.inviteSecret)
+	mac.Write([]byte(id + "." + strconv.FormatInt(expires, 10)))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// GenerateInviteLink returns a signed token resolving to an invitation until it expires. The
+// invitation must have been added with AddInvitationToPlayer so its owner is indexed.
+func (store *Store) GenerateInviteLink(invitationId string) (string, error) {
+
+	if len(store.inviteSecret) == 0 {
+		return "", fmt.Errorf("invite links need a secret, see WithInviteSecret")
+	}
+	ctx := context.Background()
+
+	var playerId string
+	if err := store.GetPath(ctx, "invitation_index/"+invitationId, &playerId); err != nil {
+		return "", err
+	}
+	if playerId == "" {
+		return "", fmt.Errorf("invitation not found: %s", invitationId)
+	}
+	inv := &models.Invitation{}
+	if err := store.GetPath(ctx, "players/"+playerId+"/invitations/"+invitationId, inv); err != nil {
+		return "", err
+	}
+
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	now := time.Now().UTC()
+	link := &InviteLink{
+		Id:           base64.RawURLEncoding.EncodeToString(b),
+		InvitationId: invitationId,
+		PlayerId:     playerId,
+		GameId:       inv.GameId,
+		GroupId:      inv.GameGroup,
+		CreatedAt:    now.Format(time.RFC3339),
+		ExpiresAt:    now.Add(DefaultInviteLinkTTL).Unix(),
+	}
+	if err := store.SetPath(ctx, "invite_links/"+link.Id, link); err != nil {
+		return "", err
+	}
+	return link.Id + "." + strconv.FormatInt(link.ExpiresAt, 10) + "." + store.signInvite(link.Id, link.ExpiresAt), nil
+}
+
+// ResolveInviteLink checks a token and returns its invitation and, for game invitations, the game
+func (store *Store) ResolveInviteLink(token string) (*models.Invitation, *models.Game, error) {
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, nil, fmt.Errorf("invalid invite link")
+	}
+	expires, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || len(store.inviteSecret) == 0 ||
+		!hmac.Equal([]byte(parts[2]), []byte(store.signInvite(parts[0], expires))) {
+		return nil, nil, fmt.Errorf("invalid invite link")
+	}
+	if time.Now().Unix() > expires {
+		return nil, nil, fmt.Errorf("invite link expired")
+	}
+
+	ctx := context.Background()
+	link := &InviteLink{}
+	if err := store.GetPath(ctx, "invite_links/"+parts[0], link); err != nil {
+		return nil, nil, err
+	}
+	if link.Id == "" {
+		// revoked
+		return nil, nil, fmt.Errorf("invalid invite link")
+	}
+
+	inv := &models.Invitation{}
+	if err := store.GetPath(ctx, "players/"+link.PlayerId+"/invitations/"+link.InvitationId, inv); err != nil {
+		return nil, nil, err
+	}
+	if inv.Bin == "" {
+		return nil, nil, fmt.Errorf("invitation not found: %s", link.InvitationId)
+	}
+	if link.GameId == "" {
+		return inv, nil, nil
+	}
+	g, err := store.getGameByBin(link.GameId)
+	if err != nil {
+		return nil, nil, err
+	}
+	return inv, g, nil
+}
+
+// RevokeInviteLink invalidates a token before it expires
+func (store *Store) RevokeInviteLink(token string) error {
+	return store.DeletePath(context.Background(), "invite_links/"+strings.SplitN(token, ".", 2)[0])
+}
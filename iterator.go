@@ -0,0 +1,114 @@
+package v1
+
+import (
+	"context"
+	models "github.com/horcu/pm-models/types"
+)
+
+// defaultPageSize is how many players an iterator fetches per request
+const defaultPageSize = 500
+
+// PlayerIterator walks every player in key order, fetching a page at a time
+//
+//	it := store.ListPlayersIter(ctx)
+//	for it.Next() {
+//		p := it.Player()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type PlayerIterator struct {
+	store    *Store
+	ctx      context.Context
+	PageSize int
+	page     []*models.Player
+	current  *models.Player
+	last     string // key of the last player fetched
+	done     bool
+	err      error
+}
+
+// ListPlayersIter returns an iterator over all players that holds at most one page in memory,
+// pages are read from the secondary when ctx comes from OnSecondary
+func (store *Store) ListPlayersIter(ctx context.Context) *PlayerIterator {
+	return &PlayerIterator{store: store, ctx: ctx, PageSize: defaultPageSize}
+}
+
+// Next advances to the next player, returning false when there are no more or an error occurred
+func (it *PlayerIterator) Next() bool {
+
+	if it.err != nil {
+		return false
+	}
+	if len(it.page) == 0 && !it.done {
+		it.err = it.fetch()
+		if it.err != nil {
+			return false
+		}
+	}
+	if len(it.page) == 0 {
+		it.current = nil
+		return false
+	}
+	it.current, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// Player returns the player Next advanced to
+func (it *PlayerIterator) Player() *models.Player {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *PlayerIterator) Err() error {
+	return it.err
+}
+
+func (it *PlayerIterator) fetch() error {
+
+	if err := it.ctx.Err(); err != nil {
+		return err
+	}
+
+	size := it.PageSize
+	if size <= 0 {
+		size = defaultPageSize
+	}
+	ref, err := it.store.readRef(it.ctx, "players")
+	if err != nil {
+		return err
+	}
+	q := ref.OrderByKey()
+	if it.last != "" {
+		// the previous page's last key comes back first
+		q = q.StartAt(it.last).LimitToFirst(size + 1)
+	} else {
+		q = q.LimitToFirst(size)
+	}
+	nodes, err := it.store.GetOrderedQuery(it.ctx, "players", q)
+	if err != nil {
+		return err
+	}
+
+	fetched := 0
+	for _, n := range nodes {
+		if n.Key() == it.last {
+			continue
+		}
+		fetched++
+		it.last = n.Key()
+
+		var raw interface{}
+		if err := n.Unmarshal(&raw); err != nil {
+			return err
+		}
+		p := &models.Player{}
+		if err := decodeVersioned("players", raw, p); err != nil {
+			return err
+		}
+		it.page = append(it.page, p)
+	}
+	if fetched < size {
+		it.done = true
+	}
+	return nil
+}
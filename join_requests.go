@@ -0,0 +1,206 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+	"log"
+	"time"
+)
+
+// join request notification types
+const (
+	NotifyJoinRequested = "group.join_requested"
+	NotifyJoinApproved  = "group.join_approved"
+	NotifyJoinRejected  = "group.join_rejected"
+)
+
+// JoinRequest is a pending request to join a group under game_groups/{id}/join_requests/{playerId}
+type JoinRequest struct {
+	PlayerId    string `json:"player_id"`
+	UserName    string `json:"user_name"`
+	RequestedAt string `json:"requested_at"`
+}
+
+// RequestToJoinGroup asks to join a group, leaving a pending request for its admins to approve
+func (store *Store) RequestToJoinGroup(playerId string, groupId string) error {
+
+	ctx := context.Background()
+	p := &models.Player{}
+	if err := store.GetPath(ctx, "players/"+playerId, p); err != nil {
+		return err
+	}
+	if p.Bin == "" {
+		return fmt.Errorf("player %s not found", playerId)
+	}
+	g := &models.Group{}
+	if err := store.GetPath(ctx, "game_groups/"+groupId, g); err != nil {
+		return err
+	}
+	if g.Bin == "" {
+		return fmt.Errorf("group %s not found", groupId)
+	}
+	if _, ok := g.Members[playerId]; ok {
+		return fmt.Errorf("player %s is already a member of %s", playerId, groupId)
+	}
+	if g.Capacity > 0 && len(g.Members) >= g.Capacity {
+		return fmt.Errorf("%w: group %s", ErrFull, groupId)
+	}
+	members := make([]string, 0, len(g.Members))
+	for id := range g.Members {
+		members = append(members, id)
+	}
+	if err := store.checkNotBlocked(playerId, members); err != nil {
+		return err
+	}
+
+	existing := &JoinRequest{}
+	if err := store.GetPath(ctx, "game_groups/"+groupId+"/join_requests/"+playerId, existing); err != nil {
+		return err
+	}
+	if existing.PlayerId != "" {
+		return fmt.Errorf("player %s already asked to join %s", playerId, groupId)
+	}
+
+	err := store.SetPath(ctx, "game_groups/"+groupId+"/join_requests/"+playerId, &JoinRequest{
+		PlayerId:    playerId,
+		UserName:    p.UserName,
+		RequestedAt: time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+
+	admins, err := store.groupAdmins(groupId)
+	if err != nil {
+		log.Printf("Error finding the admins of group %s: %v", groupId, err)
+		return nil
+	}
+	for _, id := range admins {
+		store.notifyJoinRequest(id, NotifyJoinRequested, p.UserName+" asked to join "+g.GroupName, groupId, playerId)
+	}
+	return nil
+}
+
+// GetJoinRequests returns a group's pending join requests, only admins can list them
+func (store *Store) GetJoinRequests(groupId string, actorId string) (map[string]*JoinRequest, error) {
+
+	if _, err := store.requireRole(groupId, actorId, RoleAdmin); err != nil {
+		return nil, err
+	}
+	var m map[string]*JoinRequest
+	if err := store.GetPath(context.Background(), "game_groups/"+groupId+"/join_requests", &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ApproveJoinRequest adds the requesting player to the group as a plain member on behalf of an admin,
+// putting them on the waiting list with ErrWaitlisted when the group is full
+func (store *Store) ApproveJoinRequest(groupId string, actorId string, playerId string) error {
+
+	if _, err := store.requireRole(groupId, actorId, RoleAdmin); err != nil {
+		return err
+	}
+	req, err := store.getJoinRequest(groupId, playerId)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	p := &models.Player{}
+	if err := store.GetPath(ctx, "players/"+playerId, p); err != nil {
+		return err
+	}
+	if p.Bin == "" {
+		return fmt.Errorf("player %s not found", playerId)
+	}
+	members, err := store.GetShallowKeys("game_groups/" + groupId + "/members")
+	if err != nil {
+		return err
+	}
+	if err := store.checkNotBlocked(playerId, members); err != nil {
+		return err
+	}
+	full, err := store.groupIsFull(groupId)
+	if err != nil {
+		return err
+	}
+	if full {
+		// the approved player waits for the next free slot
+		if err := store.DeletePath(ctx, "game_groups/"+groupId+"/join_requests/"+playerId); err != nil {
+			return err
+		}
+		return store.waitlistMember(groupId, playerId)
+	}
+
+	err = store.UpdateGameGroup(groupId, map[string]interface{}{
+		"members/" + playerId:       memberNode(p, RoleMember),
+		"join_requests/" + playerId: nil,
+	})
+	if err != nil {
+		return err
+	}
+	store.addPlayerRef(playerId, "groups", groupId)
+
+	store.notifyJoinRequest(req.PlayerId, NotifyJoinApproved, "Your request to join a group was approved", groupId, playerId)
+	return nil
+}
+
+// RejectJoinRequest drops a pending join request on behalf of an admin
+func (store *Store) RejectJoinRequest(groupId string, actorId string, playerId string) error {
+
+	if _, err := store.requireRole(groupId, actorId, RoleAdmin); err != nil {
+		return err
+	}
+	req, err := store.getJoinRequest(groupId, playerId)
+	if err != nil {
+		return err
+	}
+	if err := store.DeletePath(context.Background(), "game_groups/"+groupId+"/join_requests/"+playerId); err != nil {
+		return err
+	}
+
+	store.notifyJoinRequest(req.PlayerId, NotifyJoinRejected, "Your request to join a group was declined", groupId, playerId)
+	return nil
+}
+
+func (store *Store) getJoinRequest(groupId string, playerId string) (*JoinRequest, error) {
+
+	req := &JoinRequest{}
+	if err := store.GetPath(context.Background(), "game_groups/"+groupId+"/join_requests/"+playerId, req); err != nil {
+		return nil, err
+	}
+	if req.PlayerId == "" {
+		return nil, fmt.Errorf("no join request from %s to %s", playerId, groupId)
+	}
+	return req, nil
+}
+
+// groupAdmins returns the owner and admins of a group
+func (store *Store) groupAdmins(groupId string) ([]string, error) {
+
+	members, err := store.GetShallowKeys("game_groups/" + groupId + "/members")
+	if err != nil {
+		return nil, err
+	}
+	var admins []string
+	for _, id := range members {
+		role, err := store.GetMemberRole(groupId, id)
+		if err != nil {
+			return nil, err
+		}
+		if roleRank[role] >= roleRank[RoleAdmin] {
+			admins = append(admins, id)
+		}
+	}
+	return admins, nil
+}
+
+func (store *Store) notifyJoinRequest(to string, kind string, title string, groupId string, playerId string) {
+
+	n := &Notification{Type: kind, Title: title, GroupId: groupId, Data: map[string]interface{}{"player_id": playerId}}
+	if err := store.CreateNotification(to, n); err != nil {
+		log.Printf("Error notifying player %s of %s: %v", to, kind, err)
+	}
+}
@@ -0,0 +1,282 @@
+package v1
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"log"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// JournalEntry is a mutation queued while the database was unreachable. Its id doubles as the
+// idempotency key: replay writes journal_applied/{id} in the same update as the mutation and
+// skips entries whose marker already exists.
+type JournalEntry struct {
+	Id        string          `json:"id"`
+	Op        string          `json:"op"`
+	Path      string          `json:"path"`
+	Value     json.RawMessage `json:"value,omitempty"`
+	TimeStamp int64           `json:"timestamp"` // unix milliseconds
+}
+
+type journal struct {
+	mu      sync.Mutex
+	path    string
+	file    *os.File
+	pending int
+}
+
+// OpenJournal makes the store queue sets, updates and deletes in an append only file at path when
+// the database is unreachable and replay them in order once it answers again. Entries left over
+// from a previous run are replayed on the next write or by ReplayJournal.
+func (store *Store) OpenJournal(path string) error {
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	entries, err := readJournal(f)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	store.journal = &journal{path: path, file: f, pending: len(entries)}
+	return nil
+}
+
+// CloseJournal closes the journal file, queued entries stay in it
+func (store *Store) CloseJournal() error {
+
+	if store.journal == nil {
+		return nil
+	}
+	j := store.journal
+	store.journal = nil
+	return j.file.Close()
+}
+
+// JournalPending returns how many mutations are waiting to be replayed
+func (store *Store) JournalPending() int {
+
+	if store.journal == nil {
+		return 0
+	}
+	store.journal.mu.Lock()
+	defer store.journal.mu.Unlock()
+	return store.journal.pending
+}
+
+// unreachable reports whether err means the database could not be reached at all: dial, dns and
+// refused connections. Timeouts of a sent request aren't, the server may have applied the write,
+// so they go back to the caller rather than into the journal.
+func unreachable(err error) bool {
+	var oe *net.OpError
+	if errors.As(err, &oe) && oe.Op == "dial" {
+		return true
+	}
+	var de *net.DNSError
+	if errors.As(err, &de) {
+		return true
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "dial tcp") || strings.Contains(msg, "no such host") || strings.Contains(msg, "connection refused")
+}
+
+// journalPending queues a mutation behind journaled ones so the order is kept, it reports
+// whether the mutation was queued
+func (store *Store) journalPending(ctx context.Context, op string, path string, v interface{}) (bool, error) {
+
+	if store.JournalPending() == 0 {
+		return false, nil
+	}
+	if _, err := store.ReplayJournal(ctx); err == nil && store.JournalPending() == 0 {
+		return false, nil
+	}
+	return true, store.appendJournal(op, path, v)
+}
+
+func (store *Store) appendJournal(op string, path string, v interface{}) error {
+
+	e := &JournalEntry{
+		Id:        uuid.New().String(),
+		Op:        op,
+		Path:      strings.Trim(path, "/"),
+		TimeStamp: time.Now().UnixMilli(),
+	}
+	if v != nil {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		e.Value = b
+	}
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	j := store.journal
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if _, err := j.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("error journaling %s %s: %v", op, path, err)
+	}
+	if err := j.file.Sync(); err != nil {
+		return fmt.Errorf("error journaling %s %s: %v", op, path, err)
+	}
+	j.pending++
+	log.Printf("Database unreachable, journaled %s %s", op, path)
+	return nil
+}
+
+func readJournal(f *os.File) ([]*JournalEntry, error) {
+
+	if _, err := f.Seek(0, 0); err != nil {
+		return nil, err
+	}
+	var entries []*JournalEntry
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for sc.Scan() {
+		if len(bytes.TrimSpace(sc.Bytes())) == 0 {
+			continue
+		}
+		e := &JournalEntry{}
+		if err := json.Unmarshal(sc.Bytes(), e); err != nil {
+			// a torn last line from a crash mid write, everything before it is intact
+			log.Printf("Error reading journal entry, ignoring the rest: %v", err)
+			break
+		}
+		entries = append(entries, e)
+	}
+	return entries, sc.Err()
+}
+
+// ReplayJournal applies the queued mutations in order and returns how many were applied. It stops
+// at the first failure and keeps that entry and the ones after it.
+func (store *Store) ReplayJournal(ctx context.Context) (int, error) {
+
+	if store.journal == nil {
+		return 0, nil
+	}
+	j := store.journal
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	entries, err := readJournal(j.file)
+	if err != nil {
+		return 0, err
+	}
+	// entries stay in the journal, a dry run must not lose them
+	if store.isDryRun(ctx) {
+		for _, e := range entries {
+			store.logDryRun(e.Op, e.Path, e.Value)
+		}
+		return 0, nil
+	}
+
+	applied := 0
+	var replayErr error
+	for _, e := range entries {
+		if err := store.applyJournalEntry(ctx, e); err != nil {
+			replayErr = fmt.Errorf("error replaying %s %s: %v", e.Op, e.Path, err)
+			break
+		}
+		store.recordChange(ctx, e.Path, e.Op)
+		applied++
+	}
+	if applied == 0 {
+		return 0, replayErr
+	}
+	if err := j.rewrite(entries[applied:]); err != nil {
+		return applied, err
+	}
+	return applied, replayErr
+}
+
+// applyJournalEntry writes an entry together with its applied marker in one root update
+func (store *Store) applyJournalEntry(ctx context.Context, e *JournalEntry) error {
+
+	marker := "journal_applied/" + e.Id
+	var done interface{}
+	if err := store.NewRef(marker).Get(ctx, &done); err != nil {
+		return err
+	}
+	if done != nil {
+		return nil
+	}
+
+	var v interface{}
+	if len(e.Value) > 0 {
+		dec := json.NewDecoder(bytes.NewReader(e.Value))
+		dec.UseNumber()
+		if err := dec.Decode(&v); err != nil {
+			return err
+		}
+	}
+
+	m := map[string]interface{}{marker: e.TimeStamp}
+	switch e.Op {
+	case OpSet:
+		m[e.Path] = v
+	case OpDelete:
+		m[e.Path] = nil
+	case OpUpdate:
+		children, _ := v.(map[string]interface{})
+		for k, c := range children {
+			k = strings.Trim(k, "/")
+			if e.Path != "" {
+				k = e.Path + "/" + k
+			}
+			m[k] = c
+		}
+	default:
+		return fmt.Errorf("unknown journal operation: %s", e.Op)
+	}
+
+	if store.shards != nil {
+		return store.updateShardedRoot(ctx, m)
+	}
+	return store.NewRef("/").Update(ctx, m)
+}
+
+// rewrite replaces the journal file with the entries still to replay
+func (j *journal) rewrite(entries []*JournalEntry) error {
+
+	var buf bytes.Buffer
+	for _, e := range entries {
+		line, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		buf.Write(append(line, '\n'))
+	}
+
+	tmp := j.path + ".tmp"
+	if err := os.WriteFile(tmp, buf.Bytes(), 0o600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, j.path); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(j.path, os.O_RDWR|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	j.file.Close()
+	j.file = f
+	j.pending = len(entries)
+	return nil
+}
@@ -0,0 +1,45 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestUnreachable(t *testing.T) {
+
+	post := func(err error) error {
+		return &url.Error{Op: "Post", URL: "https://pm-store-test.firebaseio.com/games.json", Err: err}
+	}
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"dial", post(&net.OpError{Op: "dial", Net: "tcp", Err: errors.New("network is unreachable")}), true},
+		{"dial timeout", post(&net.OpError{Op: "dial", Net: "tcp", Err: timeoutError{}}), true},
+		{"dns", post(&net.DNSError{Err: "no such host", Name: "pm-store-test.firebaseio.com", IsNotFound: true}), true},
+		{"refused", post(&net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}), true},
+		// the request was sent, the server may have applied it
+		{"read timeout", post(&net.OpError{Op: "read", Net: "tcp", Err: timeoutError{}}), false},
+		{"client timeout", post(timeoutError{}), false},
+		{"deadline", post(context.DeadlineExceeded), false},
+		{"server error", fmt.Errorf("http error status: 500"), false},
+	}
+	for _, c := range cases {
+		if got := unreachable(c.err); got != c.want {
+			t.Errorf("%s: unreachable(%v) = %v, want %v", c.name, c.err, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"firebase.google.com/go/db"
+	"fmt"
+	"math"
+	"time"
+)
+
+// commendation categories
+var commendCategories = []string{"friendly", "helpful", "good_sport", "strategist", "leader"}
+
+// KarmaHalfLife is the time after which a commendation counts for half as much
+var KarmaHalfLife = 30 * 24 * time.Hour
+
+// ErrAlreadyCommended is returned when a gamer commends the same player twice for one game
+var ErrAlreadyCommended = errors.New("player was already commended for this game")
+
+// Commendation is a thank you from one gamer to another, stored under games/{id}/commendations/{from}/{to}
+type Commendation struct {
+	GameId    string `json:"game_id"`
+	FromId    string `json:"from_id"`
+	ToId      string `json:"to_id"`
+	Category  string `json:"category"`
+	TimeStamp string `json:"timestamp"`
+}
+
+// Karma is the aggregate of a player's commendations kept under players/{id}/karma. Scores decay
+// with KarmaHalfLife, the stored values are as of UpdatedAt.
+type Karma struct {
+	Score      float64            `json:"score"`
+	Categories map[string]float64 `json:"categories,omitempty"`
+	Total      int                `json:"total"`      // commendations ever received, never decays
+	UpdatedAt  int64              `json:"updated_at"` // unix seconds
+}
+
+// decayed returns the karma as of now
+func (k *Karma) decayed(now time.Time) *Karma {
+
+	out := &Karma{Total: k.Total, UpdatedAt: now.Unix(), Categories: make(map[string]float64, len(k.Categories))}
+	factor := 1.0
+	if k.UpdatedAt > 0 && now.Unix() > k.UpdatedAt {
+		elapsed := time.Duration(now.Unix()-k.UpdatedAt) * time.Second
+		factor = math.Pow(0.5, float64(elapsed)/float64(KarmaHalfLife))
+	}
+	out.Score = k.Score * factor
+	for c, v := range k.Categories {
+		out.Categories[c] = v * factor
+	}
+	return out
+}
+
+// CommendPlayer commends a player for a category, once per pair of gamers and game. Both have to
+// have played the game and it has to be over.
+func (store *Store) CommendPlayer(gameId string, fromId string, toId string, category string) error {
+
+	if fromId == "" || toId == "" || fromId == toId {
+		return fmt.Errorf("invalid commendation")
+	}
+	if !contains(commendCategories, category) {
+		return fmt.Errorf("invalid commendation category: %s", category)
+	}
+	if IsBot(toId) {
+		return fmt.Errorf("bots can't be commended")
+	}
+
+	ctx := context.Background()
+	var status string
+	if err := store.GetPath(ctx, "games/"+gameId+"/status", &status); err != nil {
+		return err
+	}
+	if status != "ended" {
+		return fmt.Errorf("game %s has not ended", gameId)
+	}
+	for _, id := range []string{fromId, toId} {
+		var bin string
+		if err := store.GetPath(ctx, "games/"+gameId+"/gamers/"+id+"/bin", &bin); err != nil {
+			return err
+		}
+		if bin == "" {
+			return fmt.Errorf("%s did not play game %s", id, gameId)
+		}
+	}
+
+	now := time.Now()
+	c := &Commendation{GameId: gameId, FromId: fromId, ToId: toId, Category: category, TimeStamp: FormatTime(now)}
+	err := store.TransactionPath(ctx, "games/"+gameId+"/commendations/"+fromId+"/"+toId, func(t db.TransactionNode) (interface{}, error) {
+		var current Commendation
+		if err := t.Unmarshal(&current); err != nil {
+			return nil, err
+		}
+		if current.FromId != "" {
+			return nil, ErrAlreadyCommended
+		}
+		return c, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return store.TransactionPath(ctx, "players/"+toId+"/karma", func(t db.TransactionNode) (interface{}, error) {
+		var current Karma
+		if err := t.Unmarshal(&current); err != nil {
+			return nil, err
+		}
+		k := current.decayed(now)
+		k.Score++
+		k.Categories[category]++
+		k.Total++
+		return k, nil
+	})
+}
+
+// GetKarma returns a player's karma decayed to now
+func (store *Store) GetKarma(playerId string) (*Karma, error) {
+
+	k := &Karma{}
+	if err := store.GetPath(context.Background(), "players/"+playerId+"/karma", k); err != nil {
+		return nil, err
+	}
+	return k.decayed(time.Now()), nil
+}
@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"context"
+	"firebase.google.com/go/db"
+)
+
+// ListOptions orders and limits a list query on the database server. Ordering by a child needs
+// an .indexOn rule for that child, otherwise the server rejects the query.
+type ListOptions struct {
+	OrderBy string      // child to order by, "$value" for the value itself, empty orders by key
+	StartAt interface{} // first key, or first child value when OrderBy is set
+	Limit   int         // maximum number of entries, zero for all of them
+	Fields  Projection  // fields kept by player lists, empty for the public profile
+}
+
+func firstListOptions(opts []ListOptions) ListOptions {
+	if len(opts) == 0 {
+		return ListOptions{}
+	}
+	return opts[0]
+}
+
+// queryList returns the children of path in the order and range the options describe
+func (store *Store) queryList(ctx context.Context, path string, opts ListOptions) ([]db.QueryNode, error) {
+
+	ref := store.NewRef(path)
+	var q *db.Query
+	switch opts.OrderBy {
+	case "", "$key":
+		q = ref.OrderByKey()
+	case "$value":
+		q = ref.OrderByValue()
+	default:
+		q = ref.OrderByChild(opts.OrderBy)
+	}
+	if opts.StartAt != nil {
+		q = q.StartAt(opts.StartAt)
+	}
+	if opts.Limit > 0 {
+		q = q.LimitToFirst(opts.Limit)
+	}
+	return store.GetOrderedQuery(ctx, path, q)
+}
@@ -0,0 +1,156 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+	"strings"
+)
+
+// DefaultLocale is the last catalog tried when a key has no text in the requested locale
+const DefaultLocale = "en"
+
+// catalogKey makes a message key such as step.night.command usable as a database key
+func catalogKey(key string) string {
+	return strings.NewReplacer(".", ":", "/", ":", "$", "_", "#", "_", "[", "_", "]", "_").Replace(key)
+}
+
+// localeChain returns the locales tried for a locale, e.g. pt-BR, pt, en
+func localeChain(locale string) []string {
+
+	locale = strings.ReplaceAll(strings.TrimSpace(locale), "_", "-")
+	var chain []string
+	if locale != "" {
+		chain = append(chain, locale)
+		if i := strings.Index(locale, "-"); i > 0 {
+			chain = append(chain, locale[:i])
+		}
+	}
+	if !contains(chain, DefaultLocale) {
+		chain = append(chain, DefaultLocale)
+	}
+	return chain
+}
+
+// SetCatalogEntries adds or replaces texts of a locale's catalog under locales/{locale}, by message key
+func (store *Store) SetCatalogEntries(locale string, entries map[string]string) error {
+
+	if locale == "" || len(entries) == 0 {
+		return fmt.Errorf("a locale and at least one entry are required")
+	}
+	m := make(map[string]interface{}, len(entries))
+	for k, text := range entries {
+		m[catalogKey(k)] = text
+	}
+	return store.UpdatePath(context.Background(), "locales/"+locale, m)
+}
+
+// GetCatalog returns a locale's catalog by database key
+func (store *Store) GetCatalog(locale string) (map[string]string, error) {
+
+	var m map[string]string
+	if err := store.GetPath(context.Background(), "locales/"+locale, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// translator resolves keys through a locale chain, loading each catalog once
+type translator struct {
+	store    *Store
+	chain    []string
+	catalogs map[string]map[string]string
+}
+
+func (store *Store) newTranslator(locale string) *translator {
+	return &translator{store: store, chain: localeChain(locale), catalogs: make(map[string]map[string]string)}
+}
+
+// text returns the text of key in the first locale that has it, or key itself when none does so
+// literal texts stored before localization still read back
+func (t *translator) text(key string) (string, error) {
+
+	if key == "" {
+		return "", nil
+	}
+	for _, locale := range t.chain {
+		catalog, ok := t.catalogs[locale]
+		if !ok {
+			var err error
+			if catalog, err = t.store.GetCatalog(locale); err != nil {
+				return "", err
+			}
+			t.catalogs[locale] = catalog
+		}
+		if text, ok := catalog[catalogKey(key)]; ok {
+			return text, nil
+		}
+	}
+	return key, nil
+}
+
+func (t *translator) step(step *models.Step) (*models.Step, error) {
+
+	s := *step
+	var err error
+	if s.Command, err = t.text(step.Command); err != nil {
+		return nil, err
+	}
+	if s.Text, err = t.text(step.Text); err != nil {
+		return nil, err
+	}
+	if len(step.SubSteps) > 0 {
+		s.SubSteps = make(map[string]*models.Step, len(step.SubSteps))
+		for bin, sub := range step.SubSteps {
+			if sub == nil {
+				continue
+			}
+			if s.SubSteps[bin], err = t.step(sub); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &s, nil
+}
+
+// Translate returns the text of a message key in a locale, falling back to its language and the default locale
+func (store *Store) Translate(key string, locale string) (string, error) {
+	return store.newTranslator(locale).text(key)
+}
+
+// GetStepForLocale returns a game's step with its command and text resolved for a locale, nil when
+// the step doesn't exist
+func (store *Store) GetStepForLocale(gameId string, stepId string, locale string) (*models.Step, error) {
+
+	ctx := context.Background()
+	step := &models.Step{}
+	if err := store.GetPath(ctx, "games/"+gameId+"/steps/"+stepId, step); err != nil {
+		return nil, err
+	}
+	if step.Bin == "" {
+		if err := store.GetPath(ctx, "steps/"+stepId, step); err != nil {
+			return nil, err
+		}
+	}
+	if step.Bin == "" {
+		return nil, nil
+	}
+	return store.newTranslator(locale).step(step)
+}
+
+// LocalizeMessage returns a copy of a message with its payload text resolved for a locale
+func (store *Store) LocalizeMessage(msg *models.Message, locale string) (*models.Message, error) {
+
+	if msg == nil || msg.Payload == nil {
+		return msg, nil
+	}
+	text, err := store.Translate(msg.Payload.Text, locale)
+	if err != nil {
+		return nil, err
+	}
+	m := *msg
+	payload := *msg.Payload
+	payload.Text = text
+	m.Payload = &payload
+	return &m, nil
+}
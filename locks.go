@@ -0,0 +1,99 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"firebase.google.com/go/db"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+// stepLockTTL bounds how long a crashed instance can hold a game's step lock
+const stepLockTTL = 30 * time.Second
+
+// ErrLockHeld is returned when another owner holds an unexpired lock
+var ErrLockHeld = errors.New("lock is held by another owner")
+
+// ErrStepChanged is returned when a game is advanced from a step it already left
+var ErrStepChanged = errors.New("game is no longer at the expected step")
+
+// lockRecord is stored under locks/{path} while a lock is held
+type lockRecord struct {
+	Owner     string `json:"owner"`
+	ExpiresAt int64  `json:"expires_at"` // unix milliseconds
+}
+
+// Lock is a lease on a path shared by every instance using the database. It expires after its ttl
+// so a crashed holder can't block others forever.
+type Lock struct {
+	store *Store
+	path  string
+	owner string
+}
+
+func lockPath(path string) string {
+	return "locks/" + strings.Trim(path, "/")
+}
+
+// AcquireLock takes the lock on path for ttl, ErrLockHeld when someone else holds it
+func (store *Store) AcquireLock(path string, ttl time.Duration) (*Lock, error) {
+
+	l := &Lock{store: store, path: lockPath(path), owner: uuid.New().String()}
+	if err := l.lease(ttl, true); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// lease writes the lock record, taking over an expired one only when acquiring
+func (l *Lock) lease(ttl time.Duration, acquire bool) error {
+
+	return l.store.TransactionPath(context.Background(), l.path, func(t db.TransactionNode) (interface{}, error) {
+		current := &lockRecord{}
+		if err := t.Unmarshal(current); err != nil {
+			return nil, err
+		}
+		now := time.Now().UnixMilli()
+		free := current.Owner == "" || (acquire && current.ExpiresAt <= now)
+		if !free && current.Owner != l.owner {
+			return nil, ErrLockHeld
+		}
+		return &lockRecord{Owner: l.owner, ExpiresAt: now + ttl.Milliseconds()}, nil
+	})
+}
+
+// Extend renews the lock for another ttl, ErrLockHeld when it expired and was taken over
+func (l *Lock) Extend(ttl time.Duration) error {
+	return l.lease(ttl, false)
+}
+
+// Release frees the lock, releasing a lock that was already taken over is a no-op
+func (l *Lock) Release() error {
+
+	err := l.store.TransactionPath(context.Background(), l.path, func(t db.TransactionNode) (interface{}, error) {
+		current := &lockRecord{}
+		if err := t.Unmarshal(current); err != nil {
+			return nil, err
+		}
+		if current.Owner != l.owner {
+			return nil, ErrLockHeld
+		}
+		return nil, nil
+	})
+	if errors.Is(err, ErrLockHeld) {
+		return nil
+	}
+	return err
+}
+
+// withGameLock runs fn holding the game's lock of the given name
+func (store *Store) withGameLock(gameId string, name string, fn func() error) error {
+
+	l, err := store.AcquireLock("games/"+gameId+"/"+name, stepLockTTL)
+	if err != nil {
+		return err
+	}
+	defer l.Release()
+	return fn()
+}
@@ -0,0 +1,10 @@
+package v1_test
+
+import (
+	"github.com/horcu/pm-store/testsupport"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	testsupport.Main(m)
+}
@@ -0,0 +1,124 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+)
+
+// ErrMaintenance is returned by every write while the database is in maintenance mode
+var ErrMaintenance = errors.New("the database is in maintenance mode")
+
+// maintenanceTTL is how long a store trusts the flag it last read before checking it again
+const maintenanceTTL = 5 * time.Second
+
+// Maintenance is the global maintenance flag under maintenance. While it is enabled writes fail
+// with ErrMaintenance and reads keep working.
+type Maintenance struct {
+	Enabled   bool   `json:"enabled"`
+	Message   string `json:"message,omitempty"` // shown to players, e.g. "back at 10:00 UTC"
+	SetBy     string `json:"set_by,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+type maintenanceState struct {
+	mu      sync.Mutex
+	current Maintenance
+	checked time.Time
+	bypass  bool
+}
+
+// WithMaintenanceBypass lets the store write during maintenance, for the migrations and admin
+// tools the maintenance window is for
+func WithMaintenanceBypass() StoreOption {
+	return func(store *Store) {
+		store.maintenance.bypass = true
+	}
+}
+
+// SetMaintenance turns maintenance mode on or off on behalf of an admin. The flag is written with a
+// raw ref so turning it off isn't itself refused.
+func (store *Store) SetMaintenance(enabled bool, message string, actorId string) error {
+
+	m := Maintenance{Enabled: enabled, Message: message, SetBy: actorId, UpdatedAt: time.Now().UTC().Format(time.RFC3339)}
+	if store.dryRun {
+		store.logDryRun(OpSet, "maintenance", m)
+		return nil
+	}
+	if err := store.NewRef("maintenance").Set(context.Background(), m); err != nil {
+		return wrapErr(OpSet, "maintenance", err)
+	}
+	store.maintenance.mu.Lock()
+	store.maintenance.current = m
+	store.maintenance.checked = time.Now()
+	store.maintenance.mu.Unlock()
+	return nil
+}
+
+// GetMaintenance returns the maintenance flag, read again once the last read is older than a few seconds
+func (store *Store) GetMaintenance() (Maintenance, error) {
+
+	s := &store.maintenance
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if time.Since(s.checked) < maintenanceTTL {
+		return s.current, nil
+	}
+
+	var m Maintenance
+	ctx, cancel := store.timeoutContext(context.Background(), false)
+	defer cancel()
+	if err := store.NewRef("maintenance").Get(ctx, &m); err != nil {
+		return s.current, wrapErr(OpGet, "maintenance", err)
+	}
+	s.current = m
+	s.checked = time.Now()
+	return m, nil
+}
+
+// checkMaintenance returns ErrMaintenance for writes made during maintenance. A flag that can't be
+// read doesn't block writes, an unreachable database fails them anyway.
+func (store *Store) checkMaintenance(op string, path string) error {
+
+	if store.maintenance.bypass {
+		return nil
+	}
+	m, err := store.GetMaintenance()
+	if err != nil {
+		log.Printf("Error checking maintenance mode: %v", err)
+	}
+	if m.Enabled {
+		return wrapErr(op, path, ErrMaintenance)
+	}
+	return nil
+}
+
+// WatchMaintenance streams the maintenance flag every time it changes, starting with the current
+// value, until ctx is done, so game servers can drain before a migration. The channel is closed
+// when the watch stops.
+func (store *Store) WatchMaintenance(ctx context.Context) <-chan Maintenance {
+
+	ch := make(chan Maintenance, 1)
+	go func() {
+		defer close(ch)
+		store.pollNode(ctx, "maintenance", func(raw interface{}) bool {
+			var m Maintenance
+			if err := decodeRaw(raw, &m); err != nil {
+				log.Printf("Error decoding maintenance flag: %v", err)
+				return true
+			}
+			store.maintenance.mu.Lock()
+			store.maintenance.current = m
+			store.maintenance.checked = time.Now()
+			store.maintenance.mu.Unlock()
+			select {
+			case ch <- m:
+			case <-ctx.Done():
+			}
+			return true
+		})
+	}()
+	return ch
+}
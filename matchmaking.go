@@ -0,0 +1,370 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"firebase.google.com/go/db"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+)
+
+// defaultRating is the rating of players who have none yet
+const defaultRating = 1000
+
+var errAlreadyMatched = errors.New("queue entry already matched")
+
+// MatchmakingConfig drives the matchmaker, stored under matchmaking_config so it can be tuned live
+type MatchmakingConfig struct {
+	MatchSize        int    `json:"match_size"`         // players per match
+	BracketWidth     int    `json:"bracket_width"`      // rating distance accepted right away
+	WidenBy          int    `json:"widen_by"`           // added to the bracket every WidenEvery waited
+	WidenEvery       string `json:"widen_every"`        // a time.Duration string
+	MaxBracketWidth  int    `json:"max_bracket_width"`  // the bracket never grows past this
+	CrossRegionAfter string `json:"cross_region_after"` // wait before other regions are considered, empty never
+	RecentOpponents  string `json:"recent_opponents"`   // opponents within this long are avoided, empty allows rematches
+	TemplateId       string `json:"template_id,omitempty"`
+}
+
+// DefaultMatchmakingConfig is used while no config is stored
+func DefaultMatchmakingConfig() *MatchmakingConfig {
+	return &MatchmakingConfig{
+		MatchSize:        8,
+		BracketWidth:     100,
+		WidenBy:          50,
+		WidenEvery:       "15s",
+		MaxBracketWidth:  600,
+		CrossRegionAfter: "60s",
+		RecentOpponents:  "1h",
+	}
+}
+
+// QueueEntry is a player waiting for a match under matchmaking_queue/{playerId}
+type QueueEntry struct {
+	PlayerId   string `json:"player_id"`
+	Rating     int    `json:"rating"`
+	Region     string `json:"region"`
+	EnqueuedAt int64  `json:"enqueued_at"` // unix milliseconds
+}
+
+// MatchResult tells a queued player which game they were matched into, under matchmaking_results/{playerId}
+type MatchResult struct {
+	GameId    string   `json:"game_id"`
+	PlayerIds []string `json:"player_ids"`
+	MatchedAt string   `json:"matched_at"`
+}
+
+// SetMatchmakingConfig stores the matchmaker config
+func (store *Store) SetMatchmakingConfig(c *MatchmakingConfig) error {
+
+	if c == nil || c.MatchSize < 2 {
+		return fmt.Errorf("matches need at least two players")
+	}
+	return store.SetPath(context.Background(), "matchmaking_config", c)
+}
+
+// GetMatchmakingConfig returns the stored matchmaker config, or the default one
+func (store *Store) GetMatchmakingConfig() (*MatchmakingConfig, error) {
+
+	var c *MatchmakingConfig
+	if err := store.GetPath(context.Background(), "matchmaking_config", &c); err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return DefaultMatchmakingConfig(), nil
+	}
+	return c, nil
+}
+
+// GetPlayerRating returns a player's rating under players/{id}/rating
+func (store *Store) GetPlayerRating(playerId string) (int, error) {
+
+	var rating *int
+	if err := store.GetPath(context.Background(), "players/"+playerId+"/rating", &rating); err != nil {
+		return 0, err
+	}
+	if rating == nil {
+		return defaultRating, nil
+	}
+	return *rating, nil
+}
+
+// AdjustRatings adds the rating changes of a finished game, bots are not rated
+func (store *Store) AdjustRatings(deltas map[string]int) error {
+
+	var errs []error
+	for id, d := range deltas {
+		if d == 0 || IsBot(id) {
+			continue
+		}
+		err := store.TransactionPath(context.Background(), "players/"+id+"/rating", func(t db.TransactionNode) (interface{}, error) {
+			var rating *int
+			if err := t.Unmarshal(&rating); err != nil {
+				return nil, err
+			}
+			if rating == nil {
+				return defaultRating + d, nil
+			}
+			return *rating + d, nil
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error rating %s: %v", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// EnqueueForMatch puts a player in the matchmaking queue for a latency region
+func (store *Store) EnqueueForMatch(playerId string, region string) error {
+
+	rating, err := store.GetPlayerRating(playerId)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	return store.UpdatePath(ctx, "/", map[string]interface{}{
+		"matchmaking_queue/" + playerId: &QueueEntry{
+			PlayerId:   playerId,
+			Rating:     rating,
+			Region:     region,
+			EnqueuedAt: time.Now().UnixMilli(),
+		},
+		"matchmaking_results/" + playerId: nil,
+	})
+}
+
+// LeaveMatchQueue takes a player out of the matchmaking queue
+func (store *Store) LeaveMatchQueue(playerId string) error {
+	return store.DeletePath(context.Background(), "matchmaking_queue/"+playerId)
+}
+
+// GetMatchResult returns the match a player was put in, nil while still queued
+func (store *Store) GetMatchResult(playerId string) (*MatchResult, error) {
+
+	var r *MatchResult
+	if err := store.GetPath(context.Background(), "matchmaking_results/"+playerId, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// RunMatchmaker matches the queue every interval until ctx is done
+func (store *Store) RunMatchmaker(ctx context.Context, interval time.Duration) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := store.MatchQueue(time.Now()); err != nil {
+			log.Printf("Error matching the queue: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func parseWait(s string) (time.Duration, bool) {
+	d, err := time.ParseDuration(s)
+	return d, err == nil && d > 0
+}
+
+// bracket returns the rating distance an entry accepts after waiting since its enqueue time
+func (c *MatchmakingConfig) bracket(e *QueueEntry, now time.Time) int {
+
+	width := c.BracketWidth
+	waited := now.Sub(time.UnixMilli(e.EnqueuedAt))
+	if every, ok := parseWait(c.WidenEvery); ok && waited > 0 {
+		width += c.WidenBy * int(waited/every)
+	}
+	if c.MaxBracketWidth > 0 && width > c.MaxBracketWidth {
+		width = c.MaxBracketWidth
+	}
+	return width
+}
+
+// accepts reports whether the entry is willing to play with other at now
+func (c *MatchmakingConfig) accepts(e *QueueEntry, other *QueueEntry, now time.Time) bool {
+
+	if e.Region != other.Region {
+		after, ok := parseWait(c.CrossRegionAfter)
+		if !ok || now.Sub(time.UnixMilli(e.EnqueuedAt)) < after {
+			return false
+		}
+	}
+	distance := e.Rating - other.Rating
+	if distance < 0 {
+		distance = -distance
+	}
+	return distance <= c.bracket(e, now)
+}
+
+// recentOpponents returns who a player faced within the window
+func (store *Store) recentOpponents(playerId string, window time.Duration, now time.Time) (map[string]bool, error) {
+
+	var m map[string]int64
+	if err := store.GetPath(context.Background(), "players/"+playerId+"/recent_opponents", &m); err != nil {
+		return nil, err
+	}
+	recent := make(map[string]bool, len(m))
+	horizon := now.Add(-window).Unix()
+	for id, at := range m {
+		if at >= horizon {
+			recent[id] = true
+		}
+	}
+	return recent, nil
+}
+
+// MatchQueue forms as many matches as the queue allows, longest waiting players first, and
+// returns their game ids
+func (store *Store) MatchQueue(now time.Time) ([]string, error) {
+
+	c, err := store.GetMatchmakingConfig()
+	if err != nil {
+		return nil, err
+	}
+	var queue map[string]*QueueEntry
+	if err := store.GetPath(context.Background(), "matchmaking_queue", &queue); err != nil {
+		return nil, err
+	}
+	entries := make([]*QueueEntry, 0, len(queue))
+	for _, e := range queue {
+		if e != nil && e.PlayerId != "" {
+			entries = append(entries, e)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].EnqueuedAt < entries[j].EnqueuedAt })
+
+	window, avoidRecent := parseWait(c.RecentOpponents)
+	recent := make(map[string]map[string]bool)
+	recentOf := func(id string) map[string]bool {
+		if _, ok := recent[id]; !ok && avoidRecent {
+			r, err := store.recentOpponents(id, window, now)
+			if err != nil {
+				log.Printf("Error reading recent opponents of %s: %v", id, err)
+			}
+			recent[id] = r
+		}
+		return recent[id]
+	}
+
+	taken := make(map[string]bool)
+	var games []string
+	for _, anchor := range entries {
+		if taken[anchor.PlayerId] {
+			continue
+		}
+		group := []*QueueEntry{anchor}
+		for _, e := range entries {
+			if len(group) == c.MatchSize {
+				break
+			}
+			if e == anchor || taken[e.PlayerId] || !store.fits(c, group, e, now, recentOf) {
+				continue
+			}
+			group = append(group, e)
+		}
+		if len(group) < c.MatchSize {
+			continue
+		}
+
+		gameId, err := store.startMatch(group, c.TemplateId, now)
+		if errors.Is(err, errAlreadyMatched) {
+			continue
+		}
+		if err != nil {
+			return games, err
+		}
+		for _, e := range group {
+			taken[e.PlayerId] = true
+		}
+		games = append(games, gameId)
+	}
+	return games, nil
+}
+
+// fits reports whether e and every player already in the group accept one another
+func (store *Store) fits(c *MatchmakingConfig, group []*QueueEntry, e *QueueEntry, now time.Time, recentOf func(string) map[string]bool) bool {
+
+	for _, g := range group {
+		if !c.accepts(g, e, now) || !c.accepts(e, g, now) {
+			return false
+		}
+		if recentOf(g.PlayerId)[e.PlayerId] || recentOf(e.PlayerId)[g.PlayerId] {
+			return false
+		}
+		if blocked, err := store.IsBlocked(g.PlayerId, e.PlayerId); err != nil || blocked {
+			return false
+		}
+	}
+	return true
+}
+
+// startMatch claims the group's queue entries, creates their game and tells every player
+func (store *Store) startMatch(group []*QueueEntry, templateId string, now time.Time) (string, error) {
+
+	ctx := context.Background()
+	var claimed []*QueueEntry
+	release := func() {
+		for _, e := range claimed {
+			if err := store.SetPath(ctx, "matchmaking_queue/"+e.PlayerId, e); err != nil {
+				log.Printf("Error requeueing %s: %v", e.PlayerId, err)
+			}
+		}
+	}
+	for _, e := range group {
+		err := store.TransactionPath(ctx, "matchmaking_queue/"+e.PlayerId, func(t db.TransactionNode) (interface{}, error) {
+			current := &QueueEntry{}
+			if err := t.Unmarshal(current); err != nil {
+				return nil, err
+			}
+			if current.PlayerId == "" || current.EnqueuedAt != e.EnqueuedAt {
+				return nil, errAlreadyMatched
+			}
+			return nil, nil
+		})
+		if err != nil {
+			release()
+			return "", err
+		}
+		claimed = append(claimed, e)
+	}
+
+	// a group from a single region plays on that region's instance
+	region := group[0].Region
+	for _, e := range group {
+		if e.Region != region {
+			region = ""
+		}
+	}
+	if _, ok := store.regions[region]; !ok {
+		region = ""
+	}
+	gameId, err := store.createQuickmatchGame(group[0].PlayerId, MatchPrefs{TemplateId: templateId, Region: region})
+	if err != nil {
+		release()
+		return "", err
+	}
+	ids := make([]string, len(group))
+	for i, e := range group {
+		ids[i] = e.PlayerId
+		if _, err := store.JoinGame(e.PlayerId, gameId); err != nil {
+			log.Printf("Error joining matched player %s to %s: %v", e.PlayerId, gameId, err)
+		}
+	}
+
+	result := &MatchResult{GameId: gameId, PlayerIds: ids, MatchedAt: now.UTC().Format(time.RFC3339)}
+	m := make(map[string]interface{}, len(ids)*len(ids))
+	for _, id := range ids {
+		m["matchmaking_results/"+id] = result
+		for _, other := range ids {
+			if other != id {
+				m["players/"+id+"/recent_opponents/"+other] = now.Unix()
+			}
+		}
+	}
+	return gameId, store.UpdatePath(ctx, "/", m)
+}
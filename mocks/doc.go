@@ -0,0 +1,3 @@
+// Package mocks holds moq generated mocks of the store interfaces. Regenerate them with
+// go generate in the module root after changing a method of Store.
+package mocks
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// maxNoteLength is the longest note a player can keep about another
+const maxNoteLength = 2000
+
+// PlayerNote is a private note a player keeps about another, stored under player_notes/{ownerId}/{aboutId}.
+// It lives outside players/ so no read of either player's profile ever includes it.
+type PlayerNote struct {
+	About     string `json:"about"`
+	Text      string `json:"text"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// SetPlayerNote writes the owner's note about another player, an empty text deletes it
+func (store *Store) SetPlayerNote(ownerId string, aboutPlayerId string, text string) error {
+
+	if ownerId == "" || aboutPlayerId == "" || ownerId == aboutPlayerId {
+		return fmt.Errorf("invalid player note")
+	}
+	if len(text) > maxNoteLength {
+		return fmt.Errorf("note must be at most %d characters", maxNoteLength)
+	}
+
+	path := "player_notes/" + ownerId + "/" + aboutPlayerId
+	if text == "" {
+		return store.DeletePath(context.Background(), path)
+	}
+	return store.SetPath(context.Background(), path, &PlayerNote{
+		About:     aboutPlayerId,
+		Text:      text,
+		UpdatedAt: FormatTime(time.Now()),
+	})
+}
+
+// GetPlayerNotes returns the notes a player keeps, keyed by the player they are about
+func (store *Store) GetPlayerNotes(ownerId string) (map[string]*PlayerNote, error) {
+
+	var m map[string]*PlayerNote
+	if err := store.GetPath(context.Background(), "player_notes/"+ownerId, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
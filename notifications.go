@@ -0,0 +1,211 @@
+package v1
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"firebase.google.com/go/db"
+	"fmt"
+	"log"
+	"time"
+)
+
+// Notification is an inbox entry under players/{id}/notifications/{bin}. The unread count is
+// kept next to it in players/{id}/unread_notifications.
+type Notification struct {
+	Bin       string                 `json:"bin"`
+	Type      string                 `json:"type"` // the domain event type that caused it, or a custom one
+	Title     string                 `json:"title"`
+	Body      string                 `json:"body,omitempty"`
+	GameId    string                 `json:"game_id,omitempty"`
+	GroupId   string                 `json:"group_id,omitempty"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+	Read      bool                   `json:"read"`
+	CreatedAt string                 `json:"created_at"`
+}
+
+// notificationKey sorts chronologically, with a random suffix for notifications in the same millisecond
+func notificationKey() string {
+	b := make([]byte, 4)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("n%013d%s", time.Now().UnixMilli(), hex.EncodeToString(b))
+}
+
+// CreateNotification adds an unread notification to a player's inbox
+func (store *Store) CreateNotification(playerId string, n *Notification) error {
+
+	if n == nil || n.Title == "" {
+		return fmt.Errorf("invalid notification object")
+	}
+	// bots have no inbox
+	if IsBot(playerId) {
+		return nil
+	}
+	n.Bin = notificationKey()
+	n.Read = false
+	if n.CreatedAt == "" {
+		n.CreatedAt = time.Now().UTC().Format(time.RFC3339)
+	}
+	return store.UpdatePath(context.Background(), "players/"+playerId, map[string]interface{}{
+		"notifications/" + n.Bin: n,
+		"unread_notifications":   serverIncrement(1),
+	})
+}
+
+// MarkNotificationRead marks a notification read, lowering the unread count if it was unread
+func (store *Store) MarkNotificationRead(playerId string, bin string) error {
+
+	ctx := context.Background()
+	wasUnread := false
+	err := store.TransactionPath(ctx, "players/"+playerId+"/notifications/"+bin+"/read", func(t db.TransactionNode) (interface{}, error) {
+		var read *bool
+		if err := t.Unmarshal(&read); err != nil {
+			return nil, err
+		}
+		if read == nil {
+			// gone, leave it gone
+			wasUnread = false
+			return nil, nil
+		}
+		wasUnread = !*read
+		return true, nil
+	})
+	if err != nil || !wasUnread {
+		return err
+	}
+	return store.UpdatePath(ctx, "players/"+playerId, map[string]interface{}{"unread_notifications": serverIncrement(-1)})
+}
+
+// MarkAllNotificationsRead marks every notification of a player read
+func (store *Store) MarkAllNotificationsRead(playerId string) error {
+
+	ctx := context.Background()
+	q := store.NewRef("players/" + playerId + "/notifications").OrderByChild("read").EqualTo(false)
+	nodes, err := store.GetOrderedQuery(ctx, "players/"+playerId+"/notifications", q)
+	if err != nil {
+		return err
+	}
+	m := map[string]interface{}{"unread_notifications": 0}
+	for _, n := range nodes {
+		m["notifications/"+n.Key()+"/read"] = true
+	}
+	return store.UpdatePath(ctx, "players/"+playerId, m)
+}
+
+// DeleteNotification removes a notification from the inbox
+func (store *Store) DeleteNotification(playerId string, bin string) error {
+
+	ctx := context.Background()
+	var read *bool
+	if err := store.GetPath(ctx, "players/"+playerId+"/notifications/"+bin+"/read", &read); err != nil {
+		return err
+	}
+	if read == nil {
+		return nil
+	}
+	m := map[string]interface{}{"notifications/" + bin: nil}
+	if !*read {
+		m["unread_notifications"] = serverIncrement(-1)
+	}
+	return store.UpdatePath(ctx, "players/"+playerId, m)
+}
+
+// GetNotifications returns up to limit notifications newest first, starting before the given bin
+// (empty for the newest)
+func (store *Store) GetNotifications(playerId string, before string, limit int) ([]*Notification, error) {
+
+	if limit <= 0 {
+		limit = 20
+	}
+	q := store.NewRef("players/" + playerId + "/notifications").OrderByKey()
+	if before != "" {
+		q = q.EndAt(before)
+	}
+	nodes, err := store.GetOrderedQuery(context.Background(), "players/"+playerId+"/notifications", q.LimitToLast(limit+1))
+	if err != nil {
+		return nil, err
+	}
+	var page []*Notification
+	for i := len(nodes) - 1; i >= 0 && len(page) < limit; i-- {
+		if nodes[i].Key() == before {
+			continue
+		}
+		n := &Notification{}
+		if err := nodes[i].Unmarshal(n); err != nil {
+			return nil, err
+		}
+		page = append(page, n)
+	}
+	return page, nil
+}
+
+// UnreadNotificationCount returns how many notifications of a player are unread
+func (store *Store) UnreadNotificationCount(playerId string) (int, error) {
+
+	var n int
+	if err := store.GetPath(context.Background(), "players/"+playerId+"/unread_notifications", &n); err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		n = 0
+	}
+	return n, nil
+}
+
+// InboxPublisher is an EventPublisher writing notifications to the inboxes of the players an
+// event affects, install it next to other publishers with MultiPublisher
+type InboxPublisher struct {
+	store *Store
+}
+
+// NewInboxPublisher returns a publisher filling player inboxes from domain events
+func NewInboxPublisher(store *Store) *InboxPublisher {
+	return &InboxPublisher{store: store}
+}
+
+// Publish notifies the affected players of invitations, game starts and ends and scheduled games
+func (p *InboxPublisher) Publish(ctx context.Context, e *DomainEvent) error {
+
+	var title string
+	var players []string
+	var err error
+	switch e.Type {
+	case EventInvitationCreated:
+		title, players = "You have a new invitation", []string{e.PlayerId}
+	case EventGameStarted:
+		title = "Your game has started"
+		players, err = p.store.GetShallowKeys("games/" + e.GameId + "/gamers")
+	case EventGameEnded:
+		title = "Your game has ended"
+		players, err = p.store.GetShallowKeys("games/" + e.GameId + "/gamers")
+	case EventGameOrphaned:
+		title = "Your game lost its server"
+		players, err = p.store.GetShallowKeys("games/" + e.GameId + "/gamers")
+	case EventGamerAFK:
+		title = "A player in your game disconnected"
+		host, _ := e.Data["host"].(string)
+		players = []string{host}
+	case EventScheduleReminder:
+		title = "A scheduled game is starting soon"
+		players, err = p.store.GetShallowKeys("game_groups/" + e.GroupId + "/members")
+	case EventScheduleCancelled:
+		title = "A scheduled game was cancelled"
+		players, err = p.store.GetShallowKeys("game_groups/" + e.GroupId + "/members")
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, id := range players {
+		if id == "" {
+			continue
+		}
+		n := &Notification{Type: e.Type, Title: title, GameId: e.GameId, GroupId: e.GroupId, Data: e.Data}
+		if err := p.store.CreateNotification(id, n); err != nil {
+			log.Printf("Error notifying player %s of %s: %v", id, e.Type, err)
+		}
+	}
+	return nil
+}
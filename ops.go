@@ -0,0 +1,203 @@
+package v1
+
+import (
+	"context"
+	"firebase.google.com/go/db"
+	"strings"
+	"time"
+)
+
+// The helpers below are the only places the store talks to the database. Every
+// read and mutation goes through them so that store wide behaviour applies evenly.
+
+// GetPath reads the node at path into v
+func (store *Store) GetPath(ctx context.Context, path string, v interface{}) (err error) {
+	defer func(start time.Time) { store.timeOp(OpGet, path, v, start, err) }(time.Now())
+	ctx, cancel := store.opContext(ctx, false)
+	defer cancel()
+	ref, err := store.readRef(ctx, path)
+	if err != nil {
+		return wrapErr(OpGet, path, err)
+	}
+	if store.isShardedScan(path) {
+		return wrapErr(OpGet, path, store.getAcrossShards(ctx, ref, path, false, v))
+	}
+	return wrapErr(OpGet, path, ref.Get(ctx, v))
+}
+
+// GetShallowPath reads the keys of the node at path into v without their children
+func (store *Store) GetShallowPath(ctx context.Context, path string, v interface{}) (err error) {
+	defer func(start time.Time) { store.timeOp(OpShallow, path, v, start, err) }(time.Now())
+	ctx, cancel := store.opContext(ctx, false)
+	defer cancel()
+	ref, err := store.readRef(ctx, path)
+	if err != nil {
+		return wrapErr(OpShallow, path, err)
+	}
+	if store.isShardedScan(path) {
+		return wrapErr(OpShallow, path, store.getAcrossShards(ctx, ref, path, true, v))
+	}
+	return wrapErr(OpShallow, path, ref.GetShallow(ctx, v))
+}
+
+// GetQuery reads the result of a query built on NewRef(path) into v. Queries for the secondary
+// are built on readRef instead.
+func (store *Store) GetQuery(ctx context.Context, path string, q *db.Query, v interface{}) (err error) {
+	defer func(start time.Time) { store.timeOp(OpQuery, path, v, start, err) }(time.Now())
+	ctx, cancel := store.opContext(ctx, false)
+	defer cancel()
+	return wrapErr(OpQuery, path, q.Get(ctx, v))
+}
+
+// GetOrderedQuery returns the result of a query built on NewRef(path) in query order
+func (store *Store) GetOrderedQuery(ctx context.Context, path string, q *db.Query) (nodes []db.QueryNode, err error) {
+	defer func(start time.Time) { store.timeOp(OpQuery, path, nil, start, err) }(time.Now())
+	ctx, cancel := store.opContext(ctx, false)
+	defer cancel()
+	nodes, err = q.GetOrdered(ctx)
+	return nodes, wrapErr(OpQuery, path, err)
+}
+
+// SetPath replaces the node at path with v
+func (store *Store) SetPath(ctx context.Context, path string, v interface{}) error {
+	return store.mutate(ctx, OpSet, path, v, func(ctx context.Context) error {
+		ref, err := store.ref(path)
+		if err != nil {
+			return err
+		}
+		return ref.Set(ctx, v)
+	})
+}
+
+// UpdatePath updates the children of the node at path, keys may be nested paths. A root update
+// touching sharded games is split per instance and is then only atomic within each instance.
+func (store *Store) UpdatePath(ctx context.Context, path string, m map[string]interface{}) error {
+	return store.mutate(ctx, OpUpdate, path, m, func(ctx context.Context) error {
+		if store.shards != nil && strings.Trim(path, "/") == "" {
+			return store.updateShardedRoot(ctx, m)
+		}
+		ref, err := store.ref(path)
+		if err != nil {
+			return err
+		}
+		return ref.Update(ctx, m)
+	})
+}
+
+// PushPath appends v under path with a generated key
+func (store *Store) PushPath(ctx context.Context, path string, v interface{}) (ref *db.Ref, err error) {
+	if store.isDryRun(ctx) {
+		return store.dryRunPush(path, v), nil
+	}
+	if err := store.checkMaintenance(OpPush, path); err != nil {
+		return nil, err
+	}
+	if err := store.checkCallerVersion(ctx, OpPush, path); err != nil {
+		return nil, err
+	}
+	defer func(start time.Time) { store.timeOp(OpPush, path, v, start, err) }(time.Now())
+	opCtx, cancel := store.opContext(ctx, true)
+	defer cancel()
+	parent, err := store.ref(path)
+	if err != nil {
+		return nil, wrapErr(OpPush, path, err)
+	}
+	ref, err = parent.Push(opCtx, v)
+	if err != nil {
+		return nil, wrapErr(OpPush, path, err)
+	}
+	store.recordChange(ctx, path+"/"+ref.Key, OpSet)
+	return ref, nil
+}
+
+// DeletePath removes the node at path
+func (store *Store) DeletePath(ctx context.Context, path string) error {
+	return store.mutate(ctx, OpDelete, path, nil, func(ctx context.Context) error {
+		ref, err := store.ref(path)
+		if err != nil {
+			return err
+		}
+		return ref.Delete(ctx)
+	})
+}
+
+// TransactionPath runs fn as a transaction on the node at path
+func (store *Store) TransactionPath(ctx context.Context, path string, fn db.UpdateFn) (err error) {
+	if store.isDryRun(ctx) {
+		return store.dryRunTransaction(ctx, path, fn)
+	}
+	if err := store.checkMaintenance(OpTransaction, path); err != nil {
+		return err
+	}
+	if err := store.checkCallerVersion(ctx, OpTransaction, path); err != nil {
+		return err
+	}
+	defer func(start time.Time) { store.timeOp(OpTransaction, path, nil, start, err) }(time.Now())
+	opCtx, cancel := store.opContext(ctx, true)
+	defer cancel()
+	ref, err := store.ref(path)
+	if err != nil {
+		return wrapErr(OpTransaction, path, err)
+	}
+	if err = ref.Transaction(opCtx, fn); err != nil {
+		return wrapErr(OpTransaction, path, err)
+	}
+	store.recordChange(ctx, path, OpTransaction)
+	return nil
+}
+
+// mutate runs a set, update or delete and records it, handing it to the journal instead when one
+// is open and the database is unreachable. In a dry run it only logs it.
+func (store *Store) mutate(ctx context.Context, op string, path string, v interface{}, write func(ctx context.Context) error) error {
+	return store.runMutation(ctx, op, path, v, write, false)
+}
+
+// mutateConditional is mutate for writes that only apply to the value they were checked against.
+// They can't be journaled or folded into a multi-path update, so they fail while the database is
+// unreachable and record their change after writing.
+func (store *Store) mutateConditional(ctx context.Context, op string, path string, v interface{}, write func(ctx context.Context) error) error {
+	return store.runMutation(ctx, op, path, v, write, true)
+}
+
+func (store *Store) runMutation(ctx context.Context, op string, path string, v interface{}, write func(ctx context.Context) error, conditional bool) error {
+	if store.isDryRun(ctx) {
+		store.logDryRun(op, path, v)
+		return nil
+	}
+	if err := store.checkMaintenance(op, path); err != nil {
+		return err
+	}
+	if err := store.checkCallerVersion(ctx, op, path); err != nil {
+		return err
+	}
+	recorded := false
+	if !conditional {
+		if queued, err := store.journalPending(ctx, op, path, v); queued || err != nil {
+			return wrapErr(op, path, err)
+		}
+		var err error
+		if write, recorded, err = store.withChange(ctx, op, path, v, write); err != nil {
+			return wrapErr(op, path, err)
+		}
+	}
+	opCtx, cancel := store.opContext(ctx, true)
+	defer cancel()
+	start := time.Now()
+	err := write(opCtx)
+	store.timeOp(op, path, v, start, err)
+	if err != nil {
+		if !conditional && store.journal != nil && unreachable(err) {
+			return wrapErr(op, path, store.appendJournal(op, path, v))
+		}
+		return wrapErr(op, path, err)
+	}
+	if !recorded {
+		store.recordChange(ctx, path, op)
+	}
+	return nil
+}
+
+// serverIncrement is the server value adding n to a number atomically, usable in sets and updates
+func serverIncrement(n int) map[string]interface{} {
+	return map[string]interface{}{".sv": map[string]interface{}{"increment": n}}
+}
@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"firebase.google.com/go/db"
+	"log"
+	"strings"
+)
+
+// StoreOption configures a Store created with NewStore
+type StoreOption func(*Store)
+
+// WithRootPrefix namespaces every path the store reads or writes under prefix, e.g. "envs/staging"
+func WithRootPrefix(prefix string) StoreOption {
+	return func(store *Store) {
+		store.rootPrefix = strings.Trim(prefix, "/")
+	}
+}
+
+// RootPrefix returns the namespace the store's paths live under, empty for the database root
+func (store *Store) RootPrefix() string {
+	return store.rootPrefix
+}
+
+// fullPath returns path as seen from the database root
+func (store *Store) fullPath(path string) string {
+	path = strings.Trim(path, "/")
+	if store.rootPrefix == "" {
+		return path
+	}
+	if path == "" {
+		return store.rootPrefix
+	}
+	return store.rootPrefix + "/" + path
+}
+
+// NewRef returns a reference to path under the store's root prefix on the instance holding it.
+// It shadows the client's NewRef so callers using the embedded client directly are routed too.
+// A game whose shard can't be looked up gets a reference on the primary, for building queries;
+// the ops helpers return the lookup error instead.
+func (store *Store) NewRef(path string) *db.Ref {
+	ref, err := store.ref(path)
+	if err != nil {
+		log.Printf("Error routing %s, using the primary: %v", path, err)
+		return store.Client.NewRef(store.fullPath(path))
+	}
+	return ref
+}
@@ -0,0 +1,214 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"firebase.google.com/go/db"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+	"log"
+)
+
+// player statuses
+const (
+	PlayerAvailable = "available"
+	PlayerInGame    = "inAGame"
+	PlayerAFK       = "afk"
+)
+
+// ErrInvalidStatusTransition is returned when a player can't move from its status to the requested one
+var ErrInvalidStatusTransition = errors.New("invalid player status transition")
+
+// statusTransitions lists the statuses a player can move to from each status, "" is a player that
+// never had one
+var statusTransitions = map[string][]string{
+	"":              {PlayerAvailable, PlayerInGame, PlayerAFK},
+	PlayerAvailable: {PlayerInGame, PlayerAFK},
+	PlayerInGame:    {PlayerAvailable, PlayerAFK},
+	PlayerAFK:       {PlayerAvailable, PlayerInGame},
+}
+
+// SetPlayerStatus moves a player to status in a transaction, refusing transitions that aren't
+// allowed, and returns the previous status. Moving in game records gameId as the player's current
+// game so only that game's end resets it, without one any game the player is in does. The status
+// is copied to the player's group member entries unless the player's privacy hides it.
+//
+// Only the status is written in the transaction, the current game and the member entries follow
+// in a separate update. A failure between the two leaves the new status with the old current game
+// and member entries until the player's next status change.
+func (store *Store) SetPlayerStatus(playerId string, status string, gameId string) (string, error) {
+
+	if _, ok := statusTransitions[status]; !ok || status == "" {
+		return "", fmt.Errorf("invalid player status: %s", status)
+	}
+	ctx := context.Background()
+	var previous string
+	err := store.TransactionPath(ctx, "players/"+playerId+"/status", func(t db.TransactionNode) (interface{}, error) {
+		var current string
+		if err := t.Unmarshal(&current); err != nil {
+			return nil, err
+		}
+		previous = current
+		if current == status {
+			return current, nil
+		}
+		allowed, ok := statusTransitions[current]
+		if !ok {
+			// an unknown stored status can only be reset
+			allowed = []string{PlayerAvailable}
+		}
+		if !contains(allowed, status) {
+			return nil, fmt.Errorf("%w: %s to %s", ErrInvalidStatusTransition, current, status)
+		}
+		return status, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// an afk player keeps its game so coming back online can restore it
+	m := make(map[string]interface{})
+	switch {
+	case status == PlayerInGame && gameId != "":
+		m["players/"+playerId+"/current_game"] = gameId
+	case status == PlayerAvailable:
+		m["players/"+playerId+"/current_game"] = nil
+	}
+	if status != previous {
+		groups, err := store.memberGroups(playerId)
+		if err != nil {
+			return previous, err
+		}
+		var privacy string
+		if err := store.GetPath(ctx, "players/"+playerId+"/privacy", &privacy); err != nil {
+			return previous, err
+		}
+		// hosts see the availability of their members
+		var mirrored interface{}
+		if statusVisible(privacy) {
+			mirrored = status
+		}
+		for _, groupId := range groups {
+			m["game_groups/"+groupId+"/members/"+playerId+"/status"] = mirrored
+		}
+	}
+	if len(m) == 0 {
+		return previous, nil
+	}
+	if err := store.UpdatePath(ctx, "/", m); err != nil {
+		return previous, err
+	}
+	return previous, nil
+}
+
+// memberGroups returns the groups the player's refs name that still have them as a member
+func (store *Store) memberGroups(playerId string) ([]string, error) {
+
+	ctx := context.Background()
+	var refs map[string]bool
+	if err := store.GetPath(ctx, "player_refs/"+playerId+"/groups", &refs); err != nil {
+		return nil, err
+	}
+	var groups []string
+	for groupId := range refs {
+		var bin string
+		if err := store.GetPath(ctx, "game_groups/"+groupId+"/members/"+playerId+"/bin", &bin); err != nil {
+			return nil, err
+		}
+		if bin == playerId {
+			groups = append(groups, groupId)
+		}
+	}
+	return groups, nil
+}
+
+// syncPresenceStatus derives the player's status from a presence change. Going offline outside a
+// started game marks the player afk, a started game has the disconnect grace period instead.
+// Coming back online restores in game or available.
+func (store *Store) syncPresenceStatus(playerId string, p *Presence) error {
+
+	ctx := context.Background()
+	var status string
+	if err := store.GetPath(ctx, "players/"+playerId+"/status", &status); err != nil {
+		return err
+	}
+
+	if !p.Online {
+		if p.GameId != "" {
+			var gameStatus string
+			if err := store.GetPath(ctx, "games/"+p.GameId+"/status", &gameStatus); err != nil {
+				return err
+			}
+			if gameStatus == "started" {
+				return nil
+			}
+		}
+		if status == PlayerAFK {
+			return nil
+		}
+		_, err := store.SetPlayerStatus(playerId, PlayerAFK, "")
+		return err
+	}
+
+	if status != PlayerAFK && status != "" {
+		return nil
+	}
+	var currentGame string
+	if err := store.GetPath(ctx, "players/"+playerId+"/current_game", &currentGame); err != nil {
+		return err
+	}
+	if currentGame != "" {
+		_, err := store.SetPlayerStatus(playerId, PlayerInGame, currentGame)
+		return err
+	}
+	_, err := store.SetPlayerStatus(playerId, PlayerAvailable, "")
+	return err
+}
+
+// releasePlayers makes the players of an ended or orphaned game available again, unless they
+// already moved on to another game
+func (store *Store) releasePlayers(g *models.Game) {
+
+	ctx := context.Background()
+	for id, gamer := range g.Gamers {
+		if gamer == nil || IsBot(id) {
+			continue
+		}
+		var current string
+		if err := store.GetPath(ctx, "players/"+id+"/current_game", &current); err != nil {
+			log.Printf("Error reading the current game of player %s: %v", id, err)
+			continue
+		}
+		if current != "" && current != g.Bin {
+			continue
+		}
+		var status string
+		if err := store.GetPath(ctx, "players/"+id+"/status", &status); err != nil {
+			log.Printf("Error reading the status of player %s: %v", id, err)
+			continue
+		}
+		if status == PlayerAFK {
+			// still offline, only the game is forgotten
+			if err := store.DeletePath(ctx, "players/"+id+"/current_game"); err != nil {
+				log.Printf("Error releasing player %s from game %s: %v", id, g.Bin, err)
+			}
+			continue
+		}
+		if _, err := store.SetPlayerStatus(id, PlayerAvailable, ""); err != nil {
+			log.Printf("Error releasing player %s from game %s: %v", id, g.Bin, err)
+		}
+	}
+}
+
+// claimPlayers moves the players of a started game in game
+func (store *Store) claimPlayers(g *models.Game) {
+
+	for id, gamer := range g.Gamers {
+		if gamer == nil || IsBot(id) {
+			continue
+		}
+		if _, err := store.SetPlayerStatus(id, PlayerInGame, g.Bin); err != nil {
+			log.Printf("Error moving player %s in game %s: %v", id, g.Bin, err)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package v1
+
+import (
+	"context"
+	models "github.com/horcu/pm-models/types"
+	"log"
+)
+
+// PlayerSummary is the part of a player games and groups keep, it uses the player's field names
+// so it reads back as a models.Player
+type PlayerSummary struct {
+	Bin      string `json:"bin"`
+	UserName string `json:"user_name"`
+	Photo    string `json:"photo"`
+}
+
+// SummarizePlayer returns the summary written in place of a full player, nil for nil
+func SummarizePlayer(p *models.Player) *PlayerSummary {
+	if p == nil {
+		return nil
+	}
+	return &PlayerSummary{Bin: p.Bin, UserName: p.UserName, Photo: p.Photo}
+}
+
+// addPlayerRef remembers that a game or group node holds a copy of the player's summary, under
+// player_refs/{playerId}/{games|groups}/{id}, so profile changes can be fanned out to it
+func (store *Store) addPlayerRef(playerId string, kind string, id string) {
+
+	if playerId == "" || IsBot(playerId) {
+		return
+	}
+	if err := store.SetPath(context.Background(), "player_refs/"+playerId+"/"+kind+"/"+id, true); err != nil {
+		log.Printf("Error recording reference of %s from %s %s: %v", playerId, kind, id, err)
+	}
+}
+
+// PropagatePlayerSummary copies a player's current summary into every game and group that embeds
+// it, as creator, gamer or member, along with the status of member entries its privacy shows, and
+// forgets references to nodes that no longer hold the player
+func (store *Store) PropagatePlayerSummary(playerId string) error {
+
+	ctx := context.Background()
+	p := &models.Player{}
+	if err := store.GetPath(ctx, "players/"+playerId, p); err != nil {
+		return err
+	}
+	if p.Bin == "" {
+		return nil
+	}
+	var refs map[string]map[string]bool
+	if err := store.GetPath(ctx, "player_refs/"+playerId, &refs); err != nil {
+		return err
+	}
+
+	m := make(map[string]interface{})
+	var hosted []string
+	// holds reports whether the node's bin child is the player, so updates never create partial nodes
+	holds := func(path string) (bool, error) {
+		var bin string
+		if err := store.GetPath(ctx, path+"/bin", &bin); err != nil {
+			return false, err
+		}
+		return bin == playerId, nil
+	}
+
+	for gameId := range refs["games"] {
+		game := "games/" + gameId
+		creator, err := holds(game + "/creator")
+		if err != nil {
+			return err
+		}
+		gamer, err := holds(game + "/gamers/" + playerId)
+		if err != nil {
+			return err
+		}
+		if creator {
+			m[game+"/creator/user_name"] = p.UserName
+			m[game+"/creator/photo"] = p.Photo
+			hosted = append(hosted, gameId)
+		}
+		if gamer {
+			m[game+"/gamers/"+playerId+"/name"] = p.UserName
+			m[game+"/gamers/"+playerId+"/image_url"] = p.Photo
+		}
+		if !creator && !gamer {
+			m["player_refs/"+playerId+"/games/"+gameId] = nil
+		}
+	}
+	for groupId := range refs["groups"] {
+		group := "game_groups/" + groupId
+		creator, err := holds(group + "/creator")
+		if err != nil {
+			return err
+		}
+		member, err := holds(group + "/members/" + playerId)
+		if err != nil {
+			return err
+		}
+		if creator {
+			m[group+"/creator/user_name"] = p.UserName
+			m[group+"/creator/photo"] = p.Photo
+		}
+		if member {
+			m[group+"/members/"+playerId+"/user_name"] = p.UserName
+			m[group+"/members/"+playerId+"/photo"] = p.Photo
+			// the mirrored status follows the player's privacy
+			m[group+"/members/"+playerId+"/status"] = nil
+			if p.Status != "" && statusVisible(p.Privacy) {
+				m[group+"/members/"+playerId+"/status"] = p.Status
+			}
+		}
+		if !creator && !member {
+			m["player_refs/"+playerId+"/groups/"+groupId] = nil
+		}
+	}
+
+	if len(m) == 0 {
+		return nil
+	}
+	if err := store.UpdatePath(ctx, "/", m); err != nil {
+		return err
+	}
+	for _, gameId := range hosted {
+		store.refreshGameSummary(gameId, nil)
+	}
+	return nil
+}
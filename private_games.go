@@ -0,0 +1,216 @@
+package v1
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"firebase.google.com/go/db"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+	"log"
+	"math/big"
+	"strings"
+)
+
+// join code alphabet without look-alikes such as 0/O and 1/I
+const (
+	joinCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	joinCodeLength   = 6
+	joinCodeAttempts = 10
+)
+
+var errCodeTaken = errors.New("join code taken")
+
+func newJoinCode() (string, error) {
+	b := make([]byte, joinCodeLength)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(joinCodeAlphabet))))
+		if err != nil {
+			return "", err
+		}
+		b[i] = joinCodeAlphabet[n.Int64()]
+	}
+	return string(b), nil
+}
+
+// normalizeJoinCode makes codes typed by players case and space insensitive
+func normalizeJoinCode(code string) string {
+	return strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(code), " ", ""))
+}
+
+// reserveJoinCode claims an unused code for a game in join_codes/{code}
+func (store *Store) reserveJoinCode(gameId string) (string, error) {
+
+	for i := 0; i < joinCodeAttempts; i++ {
+		code, err := newJoinCode()
+		if err != nil {
+			return "", err
+		}
+		err = store.TransactionPath(context.Background(), "join_codes/"+code, func(t db.TransactionNode) (interface{}, error) {
+			var current string
+			if err := t.Unmarshal(&current); err != nil {
+				return nil, err
+			}
+			if current != "" {
+				return nil, errCodeTaken
+			}
+			return gameId, nil
+		})
+		if errors.Is(err, errCodeTaken) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		return code, nil
+	}
+	return "", fmt.Errorf("no free join code after %d attempts", joinCodeAttempts)
+}
+
+// CreatePrivateGame creates a game that is only joinable with the returned join code
+func (store *Store) CreatePrivateGame(g *models.Game) (string, error) {
+
+	if g == nil || g.Bin == "" {
+		return "", fmt.Errorf("invalid game object")
+	}
+	code, err := store.reserveJoinCode(g.Bin)
+	if err != nil {
+		return "", err
+	}
+	// the game and its summary are private from their first write so lobbies never list them
+	if err := store.createGame(g, map[string]interface{}{"private": true, "join_code": code}); err != nil {
+		_ = store.DeletePath(context.Background(), "join_codes/"+code)
+		return "", err
+	}
+	return code, nil
+}
+
+// GetGameIdByCode returns the game a join code belongs to, empty when the code is unknown
+func (store *Store) GetGameIdByCode(code string) (string, error) {
+
+	var gameId string
+	if err := store.GetPath(context.Background(), "join_codes/"+normalizeJoinCode(code), &gameId); err != nil {
+		return "", err
+	}
+	return gameId, nil
+}
+
+// BanFromGame keeps a player from joining a game, e.g. after being kicked
+func (store *Store) BanFromGame(gameId string, playerId string) error {
+	return store.SetPath(context.Background(), "games/"+gameId+"/bans/"+playerId, true)
+}
+
+// JoinGameByCode adds a player to the game behind a join code as a gamer, see JoinGame
+func (store *Store) JoinGameByCode(playerId string, code string) (*models.Gamer, error) {
+
+	gameId, err := store.GetGameIdByCode(code)
+	if err != nil {
+		return nil, err
+	}
+	if gameId == "" {
+		return nil, fmt.Errorf("unknown join code: %s", code)
+	}
+	return store.JoinGame(playerId, gameId)
+}
+
+// JoinGame adds a player to a game that hasn't started as a gamer, checking bans, blocks and the
+// ruleset's player limit. A player finding the game full is put on its waiting list and gets
+// ErrWaitlisted.
+func (store *Store) JoinGame(playerId string, gameId string) (*models.Gamer, error) {
+	return store.joinGame(playerId, gameId, true)
+}
+
+// joinGame is JoinGame, a full game only waitlists the player when waitlist is set and returns
+// ErrFull otherwise
+func (store *Store) joinGame(playerId string, gameId string, waitlist bool) (*models.Gamer, error) {
+
+	ctx := context.Background()
+	var status string
+	if err := store.GetPath(ctx, "games/"+gameId+"/status", &status); err != nil {
+		return nil, err
+	}
+	if status == "started" || status == "ended" || status == GameOrphaned {
+		return nil, fmt.Errorf("game %s already %s", gameId, status)
+	}
+	var banned bool
+	if err := store.GetPath(ctx, "games/"+gameId+"/bans/"+playerId, &banned); err != nil {
+		return nil, err
+	}
+	if banned {
+		return nil, fmt.Errorf("player %s is banned from game %s", playerId, gameId)
+	}
+	rules, err := store.GetGameRuleset(gameId)
+	if err != nil {
+		return nil, err
+	}
+	p := &models.Player{}
+	if err := store.GetPath(ctx, "players/"+playerId, p); err != nil {
+		return nil, err
+	}
+	if p.Bin == "" {
+		return nil, fmt.Errorf("player not found: %s", playerId)
+	}
+
+	gamer := &models.Gamer{Bin: playerId, GameId: gameId, Name: p.UserName, ImageUrl: p.Photo, IsAlive: true}
+
+	gamers, err := store.GetShallowKeys("games/" + gameId + "/gamers")
+	if err != nil {
+		return nil, err
+	}
+	if err := store.checkNotBlocked(playerId, gamers); err != nil {
+		return nil, err
+	}
+
+	if err := store.addGamer(ctx, gameId, gamer, rules.MaxPlayers); err != nil {
+		if waitlist && errors.Is(err, ErrFull) {
+			return nil, store.waitlistGamer(gameId, playerId)
+		}
+		return nil, err
+	}
+	store.addPlayerRef(playerId, "games", gameId)
+	return gamer, nil
+}
+
+// addGamer adds a gamer unless the game already has maxPlayers, the capacity check and the join
+// happen in one transaction so concurrent joins can't overfill
+func (store *Store) addGamer(ctx context.Context, gameId string, gamer *models.Gamer, maxPlayers int) error {
+
+	err := store.TransactionPath(ctx, "games/"+gameId+"/gamers", func(t db.TransactionNode) (interface{}, error) {
+		var gamers map[string]interface{}
+		if err := t.Unmarshal(&gamers); err != nil {
+			return nil, err
+		}
+		if gamers == nil {
+			gamers = make(map[string]interface{})
+		}
+		if _, ok := gamers[gamer.Bin]; ok {
+			return gamers, nil
+		}
+		if maxPlayers > 0 && len(gamers) >= maxPlayers {
+			return nil, fmt.Errorf("%w: game %s", ErrFull, gameId)
+		}
+		gamers[gamer.Bin] = gamer
+		return gamers, nil
+	})
+	if err != nil {
+		return err
+	}
+	store.refreshGameSummary(gameId, nil)
+	return nil
+}
+
+// releaseJoinCode frees the join code of a deleted private game
+func (store *Store) releaseJoinCode(gameId string) {
+
+	var code string
+	if err := store.GetPath(context.Background(), "games/"+gameId+"/join_code", &code); err != nil {
+		log.Printf("Error releasing join code of game %s: %v", gameId, err)
+		return
+	}
+	if code == "" {
+		return
+	}
+	if err := store.DeletePath(context.Background(), "join_codes/"+code); err != nil {
+		log.Printf("Error releasing join code of game %s: %v", gameId, err)
+	}
+}
@@ -0,0 +1,136 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"firebase.google.com/go/db"
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// profile rules
+const (
+	minUserNameLength = 3
+	maxUserNameLength = 20
+)
+
+// user names are keys of the usernames index, so they can't hold characters RTDB keys forbid
+var userNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// allowed player privacy values
+var privacyValues = []string{"public", "friends", "private"}
+
+// ErrUserNameTaken is returned when a profile update asks for another player's user name
+var ErrUserNameTaken = errors.New("user name is taken")
+
+// ProfilePatch is a partial player profile update, nil fields are left alone
+type ProfilePatch struct {
+	UserName *string `json:"user_name,omitempty"`
+	Photo    *string `json:"photo,omitempty"`
+	Privacy  *string `json:"privacy,omitempty"`
+}
+
+// Validate checks the patch against the profile rules, except user name uniqueness
+func (p *ProfilePatch) Validate() error {
+
+	var errs []error
+	if p.UserName != nil {
+		n := *p.UserName
+		if len(n) < minUserNameLength || len(n) > maxUserNameLength {
+			errs = append(errs, fmt.Errorf("user name must be %d to %d characters", minUserNameLength, maxUserNameLength))
+		} else if !userNamePattern.MatchString(n) {
+			errs = append(errs, fmt.Errorf("user name may only contain letters, digits, '_' and '-'"))
+		}
+	}
+	if p.Photo != nil && *p.Photo != "" {
+		u, err := url.Parse(*p.Photo)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			errs = append(errs, fmt.Errorf("invalid photo url: %s", *p.Photo))
+		}
+	}
+	if p.Privacy != nil && !contains(privacyValues, *p.Privacy) {
+		errs = append(errs, fmt.Errorf("invalid privacy: %s", *p.Privacy))
+	}
+	return errors.Join(errs...)
+}
+
+// userNameKey is the usernames/{key} index entry of a user name, case insensitive
+func userNameKey(name string) string {
+	return strings.ToLower(name)
+}
+
+// claimUserName reserves a user name for a player in the usernames index
+func (store *Store) claimUserName(playerId string, name string) error {
+
+	err := store.TransactionPath(context.Background(), "usernames/"+userNameKey(name), func(t db.TransactionNode) (interface{}, error) {
+		var owner string
+		if err := t.Unmarshal(&owner); err != nil {
+			return nil, err
+		}
+		if owner != "" && owner != playerId {
+			return nil, ErrUserNameTaken
+		}
+		return playerId, nil
+	})
+	if errors.Is(err, ErrUserNameTaken) {
+		return fmt.Errorf("%w: %s", ErrUserNameTaken, name)
+	}
+	return err
+}
+
+// UpdatePlayerProfile validates a profile patch and applies it, keeping user names unique
+func (store *Store) UpdatePlayerProfile(playerId string, patch ProfilePatch) error {
+
+	if err := patch.Validate(); err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	m := map[string]interface{}{}
+	var previous string
+	if patch.UserName != nil {
+		if err := store.GetPath(ctx, "players/"+playerId+"/user_name", &previous); err != nil {
+			return err
+		}
+		if userNameKey(previous) != userNameKey(*patch.UserName) || previous == "" {
+			if err := store.claimUserName(playerId, *patch.UserName); err != nil {
+				return err
+			}
+		}
+		m["user_name"] = *patch.UserName
+	}
+	if patch.Photo != nil {
+		m["photo"] = *patch.Photo
+	}
+	if patch.Privacy != nil {
+		m["privacy"] = *patch.Privacy
+	}
+	if len(m) == 0 {
+		return nil
+	}
+
+	if err := store.UpdatePlayer(playerId, m); err != nil {
+		if patch.UserName != nil && userNameKey(previous) != userNameKey(*patch.UserName) {
+			_ = store.DeletePath(ctx, "usernames/"+userNameKey(*patch.UserName))
+		}
+		return err
+	}
+
+	if patch.UserName != nil || patch.Photo != nil || patch.Privacy != nil {
+		if err := store.PropagatePlayerSummary(playerId); err != nil {
+			log.Printf("Error propagating the profile of %s: %v", playerId, err)
+		}
+	}
+
+	// free the old name once the new one is written
+	if previous != "" && patch.UserName != nil && userNameKey(previous) != userNameKey(*patch.UserName) {
+		var owner string
+		if err := store.GetPath(ctx, "usernames/"+userNameKey(previous), &owner); err == nil && owner == playerId {
+			_ = store.DeletePath(ctx, "usernames/"+userNameKey(previous))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package v1
+
+import (
+	"encoding/json"
+	models "github.com/horcu/pm-models/types"
+)
+
+// Projection is a field mask of json field names a read keeps, e.g. Projection{"bin", "user_name"}
+type Projection []string
+
+// AllFields keeps every field of the record
+var AllFields = Projection{"*"}
+
+// PublicProfile is the default projection of player lists, narrowed further by each player's privacy
+var PublicProfile = Projection{"bin", "user_name", "photo", "status", "privacy"}
+
+// privacyHidden lists the public profile fields a privacy level hides from other players
+var privacyHidden = map[string][]string{
+	"friends": {"status"},
+	"private": {"status", "photo"},
+}
+
+// statusVisible reports whether a privacy level lets other players see the player's status
+func statusVisible(privacy string) bool {
+	return !contains(privacyHidden[privacy], "status")
+}
+
+func (f Projection) all() bool {
+	return len(f) == 1 && f[0] == "*"
+}
+
+// ProjectPlayer returns a copy of p with only the fields of the projection, nil for a nil player
+func ProjectPlayer(p *models.Player, fields Projection) *models.Player {
+
+	if p == nil || fields.all() {
+		return p
+	}
+	var m map[string]interface{}
+	if b, err := json.Marshal(p); err == nil {
+		_ = json.Unmarshal(b, &m)
+	}
+	kept := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		if v, ok := m[f]; ok {
+			kept[f] = v
+		}
+	}
+	out := &models.Player{}
+	if b, err := json.Marshal(kept); err == nil {
+		_ = json.Unmarshal(b, out)
+	}
+	return out
+}
+
+// PublicPlayer returns the public profile of p as other players may see it, hiding what its
+// privacy level keeps to friends or to the player
+func PublicPlayer(p *models.Player) *models.Player {
+
+	if p == nil {
+		return nil
+	}
+	var fields Projection
+	for _, f := range PublicProfile {
+		if !contains(privacyHidden[p.Privacy], f) {
+			fields = append(fields, f)
+		}
+	}
+	return ProjectPlayer(p, fields)
+}
+
+// projectPlayers applies a list read's projection, PublicPlayer when none is set
+func projectPlayers(players []*models.Player, fields Projection) []*models.Player {
+
+	if fields.all() {
+		return players
+	}
+	for i, p := range players {
+		if fields == nil {
+			players[i] = PublicPlayer(p)
+		} else {
+			players[i] = ProjectPlayer(p, fields)
+		}
+	}
+	return players
+}
+
+// RedactGame returns a copy of g as viewerId may see it while the game runs: the other gamers
+// without their characters, abilities, fates and tokens, and only the viewer's own step results and
+// cycle fate. Ended games are returned whole.
+func RedactGame(g *models.Game, viewerId string) *models.Game {
+
+	if g == nil || g.Status == "ended" {
+		return g
+	}
+	out := *g
+	out.Gamers = make(map[string]*models.Gamer, len(g.Gamers))
+	for bin, gm := range g.Gamers {
+		if gm == nil || bin == viewerId {
+			out.Gamers[bin] = gm
+			continue
+		}
+		hidden := *gm
+		hidden.CharacterId = ""
+		hidden.Abilities = nil
+		hidden.Fate = nil
+		hidden.Token = nil
+		out.Gamers[bin] = &hidden
+	}
+	out.StepResults = nil
+	if r, ok := g.StepResults[viewerId]; ok {
+		out.StepResults = map[string][]*models.Result{viewerId: r}
+	}
+	// cycle fates are keyed by cycle, each cycle keeps only the viewer's own fate
+	out.CycleFate = nil
+	for cycle, cf := range g.CycleFate {
+		if cf == nil {
+			continue
+		}
+		if out.CycleFate == nil {
+			out.CycleFate = make(map[string]*models.CycleFate, len(g.CycleFate))
+		}
+		c := *cf
+		c.GamersFate = nil
+		if f, ok := cf.GamersFate[viewerId]; ok {
+			c.GamersFate = map[string]*models.Fate{viewerId: f}
+		}
+		out.CycleFate[cycle] = &c
+	}
+	out.Steps = make(map[string]*models.Step, len(g.Steps))
+	for bin, st := range g.Steps {
+		if st == nil || st.Result == nil {
+			out.Steps[bin] = st
+			continue
+		}
+		s := *st
+		s.Result = nil
+		if r, ok := st.Result[viewerId]; ok {
+			s.Result = map[string][]*models.Result{viewerId: r}
+		}
+		out.Steps[bin] = &s
+	}
+	return &out
+}
@@ -0,0 +1,75 @@
+// Package pubsub publishes store domain events to Google Cloud Pub/Sub.
+package pubsub
+
+import (
+	"cloud.google.com/go/pubsub"
+	"context"
+	"encoding/json"
+	v1 "github.com/horcu/pm-store"
+)
+
+// Publisher sends every domain event as a JSON message to a topic, with the
+// event type and game id as attributes so subscriptions can filter on them.
+type Publisher struct {
+	topic *pubsub.Topic
+}
+
+// NewPublisher returns a Publisher for the topic
+func NewPublisher(topic *pubsub.Topic) *Publisher {
+	return &Publisher{topic: topic}
+}
+
+// Publish sends the event and waits for the server to acknowledge it
+func (p *Publisher) Publish(ctx context.Context, e *v1.DomainEvent) error {
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	attrs := map[string]string{"type": e.Type}
+	if e.GameId != "" {
+		attrs["game_id"] = e.GameId
+	}
+
+	_, err = p.topic.Publish(ctx, &pubsub.Message{Data: data, Attributes: attrs}).Get(ctx)
+	return err
+}
+
+// Stop flushes pending messages
+func (p *Publisher) Stop() {
+	p.topic.Stop()
+}
+
+// AnalyticsSink forwards tracked analytics events to a topic, one message per event with the
+// event name and player id as attributes
+type AnalyticsSink struct {
+	topic *pubsub.Topic
+}
+
+// NewAnalyticsSink returns an AnalyticsSink for the topic, pass it to v1.WithAnalytics
+func NewAnalyticsSink(topic *pubsub.Topic) *AnalyticsSink {
+	return &AnalyticsSink{topic: topic}
+}
+
+// WriteEvents publishes the batch and waits for every message to be acknowledged
+func (s *AnalyticsSink) WriteEvents(ctx context.Context, events []*v1.AnalyticsEvent) error {
+
+	results := make([]*pubsub.PublishResult, 0, len(events))
+	for _, e := range events {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		attrs := map[string]string{"event": e.Event, "player_id": e.PlayerId}
+		results = append(results, s.topic.Publish(ctx, &pubsub.Message{Data: data, Attributes: attrs}))
+	}
+
+	var first error
+	for _, r := range results {
+		if _, err := r.Get(ctx); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
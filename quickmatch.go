@@ -0,0 +1,99 @@
+package v1
+
+import (
+	"github.com/google/uuid"
+	models "github.com/horcu/pm-models/types"
+	"log"
+)
+
+// quickmatchPages bounds how many lobby pages FindAndJoinGame looks through before creating a game
+const quickmatchPages = 5
+
+// MatchPrefs narrows the games quickmatch may join
+type MatchPrefs struct {
+	GroupId    string   // only games of this group
+	Tags       []string // only games of groups carrying every one of these tags
+	TemplateId string   // template of the game created when none can be joined
+	Region     string   // region of the game created when none can be joined
+}
+
+// FindAndJoinGame joins the player to a waiting public game with a free slot, found through the
+// lobby summaries, or creates a fresh game and joins it when there is none. It returns the game
+// id, whether the game was created and the new gamer.
+func (store *Store) FindAndJoinGame(playerId string, prefs MatchPrefs) (string, bool, *models.Gamer, error) {
+
+	var tagged []string
+	if len(prefs.Tags) > 0 {
+		var err error
+		if tagged, err = store.SearchGroupsByTag(prefs.Tags); err != nil {
+			return "", false, nil, err
+		}
+	}
+
+	cursor := ""
+	for page := 0; page < quickmatchPages; page++ {
+		summaries, next, err := store.ListGameSummaries(SummaryListOptions{Status: "waiting", Cursor: cursor, Limit: 50})
+		if err != nil {
+			return "", false, nil, err
+		}
+		for _, s := range summaries {
+			if s.Private || (prefs.GroupId != "" && s.GroupId != prefs.GroupId) {
+				continue
+			}
+			if len(prefs.Tags) > 0 && !contains(tagged, s.GroupId) {
+				continue
+			}
+			rules, err := store.GetGameRuleset(s.Bin)
+			if err != nil {
+				return "", false, nil, err
+			}
+			if rules.MaxPlayers > 0 && s.PlayerCount >= rules.MaxPlayers {
+				continue
+			}
+			// the join re-checks capacity, bans and blocks in its transaction, a lost race moves on
+			// without waitlisting the player
+			gamer, err := store.joinGame(playerId, s.Bin, false)
+			if err != nil {
+				log.Printf("Quickmatch skipped game %s for %s: %v", s.Bin, playerId, err)
+				continue
+			}
+			return s.Bin, false, gamer, nil
+		}
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+
+	gameId, err := store.createQuickmatchGame(playerId, prefs)
+	if err != nil {
+		return "", false, nil, err
+	}
+	gamer, err := store.joinGame(playerId, gameId, false)
+	if err != nil {
+		return "", false, nil, err
+	}
+	return gameId, true, gamer, nil
+}
+
+func (store *Store) createQuickmatchGame(playerId string, prefs MatchPrefs) (string, error) {
+
+	p, err := store.GetByBin(playerId, "players")
+	if err != nil {
+		return "", err
+	}
+	creator, _ := p.(*models.Player)
+
+	if prefs.TemplateId != "" {
+		g, err := store.instantiateTemplate(prefs.TemplateId, creator, prefs.GroupId, prefs.Region)
+		if err != nil {
+			return "", err
+		}
+		return g.Bin, nil
+	}
+	g := &models.Game{Bin: uuid.New().String(), GroupId: prefs.GroupId, Status: "waiting", Creator: creator}
+	if err := store.CreateGameInRegion(g, prefs.Region); err != nil {
+		return "", err
+	}
+	return g.Bin, nil
+}
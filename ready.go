@@ -0,0 +1,98 @@
+package v1
+
+import (
+	"context"
+	"time"
+)
+
+// defaultReadyTimeout is how long WaitAllReady waits when the ruleset sets no ready timeout
+const defaultReadyTimeout = 60 * time.Second
+
+// SetReady records a gamer's answer to the lobby ready check under games/{id}/ready
+func (store *Store) SetReady(gameId string, gamerId string, ready bool) error {
+	return store.SetPath(context.Background(), "games/"+gameId+"/ready/"+gamerId, ready)
+}
+
+// AllReady reports whether every joined gamer confirmed readiness, with the ones who did not
+func (store *Store) AllReady(gameId string) (bool, []string, error) {
+
+	gamers, err := store.GetShallowKeys("games/" + gameId + "/gamers")
+	if err != nil {
+		return false, nil, err
+	}
+	var ready map[string]bool
+	if err := store.GetPath(context.Background(), "games/"+gameId+"/ready", &ready); err != nil {
+		return false, nil, err
+	}
+
+	var unready []string
+	for _, g := range gamers {
+		if !ready[g] {
+			unready = append(unready, g)
+		}
+	}
+	return len(gamers) > 0 && len(unready) == 0, unready, nil
+}
+
+// WaitAllReady waits until every joined gamer is ready or the ruleset's ready timeout passes, in
+// which case the unready gamers are removed from the game and returned
+func (store *Store) WaitAllReady(ctx context.Context, gameId string) ([]string, error) {
+
+	rules, err := store.GetGameRuleset(gameId)
+	if err != nil {
+		return nil, err
+	}
+	timeout := defaultReadyTimeout
+	if d, err := time.ParseDuration(rules.ReadyTimeout); err == nil && d > 0 {
+		timeout = d
+	}
+
+	wctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var checkErr error
+	done := false
+	store.pollNode(wctx, "games/"+gameId+"/ready", func(raw interface{}) bool {
+		ready, _, err := store.AllReady(gameId)
+		if err != nil {
+			checkErr = err
+			cancel()
+			return true
+		}
+		if ready {
+			done = true
+			cancel()
+		}
+		return true
+	})
+	if checkErr != nil {
+		return nil, checkErr
+	}
+	if done {
+		return nil, nil
+	}
+	if err := ctx.Err(); err != nil {
+		// the caller gave up, not the ready check
+		return nil, err
+	}
+
+	// timed out, drop whoever did not confirm
+	ready, unready, err := store.AllReady(gameId)
+	if err != nil {
+		return nil, err
+	}
+	if ready || len(unready) == 0 {
+		return nil, nil
+	}
+	m := make(map[string]interface{}, len(unready)*2)
+	for _, g := range unready {
+		m["gamers/"+g] = nil
+		m["ready/"+g] = nil
+	}
+	if err := store.UpdatePath(context.Background(), "games/"+gameId, m); err != nil {
+		return nil, err
+	}
+	store.refreshGameSummary(gameId, nil)
+	store.promoteGameWaitlist(gameId)
+	return unready, nil
+}
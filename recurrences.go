@@ -0,0 +1,159 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"log"
+	"strings"
+	"time"
+)
+
+// recurrence frequencies
+const (
+	RecurDaily  = "daily"
+	RecurWeekly = "weekly"
+)
+
+// Recurrence repeats a scheduled game, stored under game_groups/{id}/recurrences/{bin}. The next
+// occurrence is scheduled when the previous one's game ends or is cancelled.
+type Recurrence struct {
+	Bin       string            `json:"bin"`
+	Frequency string            `json:"frequency"`         // daily or weekly
+	Weekday   string            `json:"weekday,omitempty"` // e.g. "friday", weekly only
+	At        string            `json:"at"`                // local wall clock time, "20:00"
+	Timezone  string            `json:"timezone"`          // IANA name, e.g. "America/New_York", defaults to the creator's
+	Settings  *ScheduleSettings `json:"settings"`
+	CreatorId string            `json:"creator_id"`
+	Active    bool              `json:"active"`
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "monday": time.Monday, "tuesday": time.Tuesday, "wednesday": time.Wednesday,
+	"thursday": time.Thursday, "friday": time.Friday, "saturday": time.Saturday,
+}
+
+// Next returns the first occurrence strictly after t
+func (r *Recurrence) Next(t time.Time) (time.Time, error) {
+
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timezone: %s", r.Timezone)
+	}
+	clock, err := time.Parse("15:04", r.At)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time of day: %s", r.At)
+	}
+
+	local := t.In(loc)
+	next := time.Date(local.Year(), local.Month(), local.Day(), clock.Hour(), clock.Minute(), 0, 0, loc)
+	switch r.Frequency {
+	case RecurDaily:
+		for !next.After(t) {
+			next = next.AddDate(0, 0, 1)
+		}
+	case RecurWeekly:
+		day, ok := weekdays[strings.ToLower(r.Weekday)]
+		if !ok {
+			return time.Time{}, fmt.Errorf("invalid weekday: %s", r.Weekday)
+		}
+		for next.Weekday() != day || !next.After(t) {
+			next = next.AddDate(0, 0, 1)
+		}
+	default:
+		return time.Time{}, fmt.Errorf("invalid frequency: %s", r.Frequency)
+	}
+	return next, nil
+}
+
+// SetGroupRecurrence stores a recurrence on a group and schedules its first occurrence
+func (store *Store) SetGroupRecurrence(groupId string, r *Recurrence) (*ScheduledGame, error) {
+
+	if r == nil {
+		return nil, fmt.Errorf("invalid recurrence object")
+	}
+	if _, err := store.requireRole(groupId, r.CreatorId, RoleAdmin); err != nil {
+		return nil, err
+	}
+	// recurrences follow the host's wall clock unless told otherwise
+	if r.Timezone == "" {
+		tz, err := store.GetPlayerTimezone(r.CreatorId)
+		if err != nil {
+			return nil, err
+		}
+		r.Timezone = tz
+	}
+	start, err := r.Next(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if r.Bin == "" {
+		r.Bin = uuid.New().String()
+	}
+	r.Active = true
+	if err := store.SetPath(context.Background(), "game_groups/"+groupId+"/recurrences/"+r.Bin, r); err != nil {
+		return nil, err
+	}
+	return store.scheduleGame(groupId, r.CreatorId, start, r.Settings, r.Bin)
+}
+
+// StopGroupRecurrence stops scheduling further occurrences, already scheduled ones stay
+func (store *Store) StopGroupRecurrence(groupId string, actorId string, bin string) error {
+
+	if _, err := store.requireRole(groupId, actorId, RoleAdmin); err != nil {
+		return err
+	}
+	return store.SetPath(context.Background(), "game_groups/"+groupId+"/recurrences/"+bin+"/active", false)
+}
+
+// GetGroupRecurrences returns the recurrences of a group
+func (store *Store) GetGroupRecurrences(groupId string) (map[string]*Recurrence, error) {
+
+	var m map[string]*Recurrence
+	if err := store.GetPath(context.Background(), "game_groups/"+groupId+"/recurrences", &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// materializeNextOccurrence schedules the next occurrence of the recurrence an ended game came from
+func (store *Store) materializeNextOccurrence(gameId string) {
+
+	q := store.NewRef("scheduled_games").OrderByChild("game_id").EqualTo(gameId)
+	nodes, err := store.GetOrderedQuery(context.Background(), "scheduled_games", q)
+	if err != nil {
+		log.Printf("Error finding the schedule of game %s: %v", gameId, err)
+		return
+	}
+	for _, n := range nodes {
+		s := &ScheduledGame{}
+		if err := n.Unmarshal(s); err != nil || s.RecurrenceId == "" {
+			continue
+		}
+		if err := store.scheduleNext(s); err != nil {
+			log.Printf("Error scheduling the next occurrence of %s: %v", s.RecurrenceId, err)
+		}
+	}
+}
+
+// scheduleNext schedules the occurrence following s if its recurrence is still active
+func (store *Store) scheduleNext(s *ScheduledGame) error {
+
+	r := &Recurrence{}
+	if err := store.GetPath(context.Background(), "game_groups/"+s.GroupId+"/recurrences/"+s.RecurrenceId, r); err != nil {
+		return err
+	}
+	if r.Bin == "" || !r.Active {
+		return nil
+	}
+	after := time.Unix(s.StartAt, 0)
+	if now := time.Now(); now.After(after) {
+		after = now
+	}
+	start, err := r.Next(after)
+	if err != nil {
+		return err
+	}
+	_, err = store.scheduleGame(s.GroupId, r.CreatorId, start, r.Settings, r.Bin)
+	return err
+}
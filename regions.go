@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+)
+
+// ErrUnknownRegion is returned when a game is created in a region missing from the routing table
+var ErrUnknownRegion = errors.New("unknown region")
+
+// WithRegions sets the routing table of regions and the database instance close to their players,
+// an empty url keeps a region on the primary. Games created in a region live on its instance and
+// are found from anywhere through the shard map and game_regions on the primary.
+func WithRegions(routes map[string]string) StoreOption {
+	return func(store *Store) {
+		if store.shards == nil {
+			store.shards = newShardRouter(nil)
+		}
+		store.regions = routes
+	}
+}
+
+// CreateGameInRegion creates a game on the instance of region, or like CreateGame when region is empty
+func (store *Store) CreateGameInRegion(g *models.Game, region string) error {
+
+	if g == nil {
+		return invalidType(g, "games")
+	}
+	if err := store.assignRegion(g.Bin, region); err != nil {
+		return err
+	}
+	return store.CreateGame(g)
+}
+
+// GameRegion returns the region a game was created in, empty when it has none
+func (store *Store) GameRegion(gameId string) (string, error) {
+
+	var region string
+	if err := store.GetPath(context.Background(), "game_regions/"+gameId, &region); err != nil {
+		return "", err
+	}
+	return region, nil
+}
+
+// assignRegion pins a game that is about to be created to its region's instance
+func (store *Store) assignRegion(gameId string, region string) error {
+
+	if region == "" {
+		return nil
+	}
+	url, ok := store.regions[region]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownRegion, region)
+	}
+	if url != "" {
+		if err := store.AssignGameShard(gameId, url); err != nil {
+			return err
+		}
+	}
+	return store.SetPath(context.Background(), "game_regions/"+gameId, region)
+}
@@ -0,0 +1,159 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// RemoteConfig holds the game tuning parameters under remote_config, read by game servers at
+// runtime so they can be tuned live. Fields missing from the database take their defaults.
+type RemoteConfig struct {
+	NightLength           string `json:"night_length"` // a time.Duration string e.g. "60s"
+	DayLength             string `json:"day_length"`
+	MaxPlayers            int    `json:"max_players"`
+	ChatMaxLength         int    `json:"chat_max_length"`          // characters per message
+	ChatMessagesPerMinute int    `json:"chat_messages_per_minute"` // per gamer
+	EmotesPerMinute       int    `json:"emotes_per_minute"`        // per gamer, see SendEmote
+	UpdatedBy             string `json:"updated_by,omitempty"`
+	UpdatedAt             string `json:"updated_at,omitempty"`
+}
+
+// DefaultRemoteConfig returns the tuning used until one is set, matching DefaultRuleset
+func DefaultRemoteConfig() *RemoteConfig {
+	r := DefaultRuleset()
+	return &RemoteConfig{
+		NightLength:           r.NightLength,
+		DayLength:             r.DayLength,
+		MaxPlayers:            r.MaxPlayers,
+		ChatMaxLength:         500,
+		ChatMessagesPerMinute: 20,
+		EmotesPerMinute:       10,
+	}
+}
+
+// Validate checks that every parameter is usable
+func (c *RemoteConfig) Validate() error {
+
+	var errs []error
+	if d, err := time.ParseDuration(c.NightLength); err != nil || d <= 0 {
+		errs = append(errs, fmt.Errorf("invalid night length: %s", c.NightLength))
+	}
+	if d, err := time.ParseDuration(c.DayLength); err != nil || d <= 0 {
+		errs = append(errs, fmt.Errorf("invalid day length: %s", c.DayLength))
+	}
+	if c.MaxPlayers < 1 {
+		errs = append(errs, fmt.Errorf("max players must be at least 1"))
+	}
+	if c.ChatMaxLength < 1 {
+		errs = append(errs, fmt.Errorf("chat max length must be at least 1"))
+	}
+	if c.ChatMessagesPerMinute < 1 {
+		errs = append(errs, fmt.Errorf("chat messages per minute must be at least 1"))
+	}
+	if c.EmotesPerMinute < 1 {
+		errs = append(errs, fmt.Errorf("emotes per minute must be at least 1"))
+	}
+	return errors.Join(errs...)
+}
+
+// NightDuration returns the parsed night length
+func (c *RemoteConfig) NightDuration() time.Duration {
+	d, _ := time.ParseDuration(c.NightLength)
+	return d
+}
+
+// DayDuration returns the parsed day length
+func (c *RemoteConfig) DayDuration() time.Duration {
+	d, _ := time.ParseDuration(c.DayLength)
+	return d
+}
+
+// remoteConfig is the last config seen by WatchRemoteConfig and the listeners told about changes
+type remoteConfig struct {
+	mu        sync.RWMutex
+	current   *RemoteConfig
+	listeners []func(old *RemoteConfig, next *RemoteConfig)
+}
+
+// SetRemoteConfig validates and stores the tuning on behalf of an admin
+func (store *Store) SetRemoteConfig(c *RemoteConfig, actorId string) error {
+
+	if c == nil {
+		return fmt.Errorf("invalid remote config object")
+	}
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	c.UpdatedBy = actorId
+	c.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	return store.SetPath(context.Background(), "remote_config", c)
+}
+
+// GetRemoteConfig returns the tuning, the watched copy when WatchRemoteConfig is running
+func (store *Store) GetRemoteConfig() (*RemoteConfig, error) {
+
+	store.remoteConfig.mu.RLock()
+	current := store.remoteConfig.current
+	store.remoteConfig.mu.RUnlock()
+	if current != nil {
+		return current, nil
+	}
+
+	c := DefaultRemoteConfig()
+	if err := store.GetPath(context.Background(), "remote_config", c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// OnRemoteConfigChange registers fn to be called by WatchRemoteConfig with the previous and the new
+// tuning every time it changes
+func (store *Store) OnRemoteConfigChange(fn func(old *RemoteConfig, next *RemoteConfig)) {
+	store.remoteConfig.mu.Lock()
+	store.remoteConfig.listeners = append(store.remoteConfig.listeners, fn)
+	store.remoteConfig.mu.Unlock()
+}
+
+// WatchRemoteConfig keeps the tuning fresh in memory until ctx is done and notifies the listeners
+// of changes. A config that fails validation, e.g. after a manual edit, is ignored.
+func (store *Store) WatchRemoteConfig(ctx context.Context) {
+
+	rc := &store.remoteConfig
+	go store.pollNode(ctx, "remote_config", func(raw interface{}) bool {
+		next := DefaultRemoteConfig()
+		if raw != nil {
+			if err := decodeRaw(raw, next); err != nil {
+				log.Printf("Error decoding remote config: %v", err)
+				return true
+			}
+		}
+		if err := next.Validate(); err != nil {
+			log.Printf("Ignoring invalid remote config: %v", err)
+			return true
+		}
+
+		rc.mu.Lock()
+		old := rc.current
+		rc.current = next
+		listeners := rc.listeners
+		rc.mu.Unlock()
+
+		if old != nil && !reflect.DeepEqual(old, next) {
+			for _, fn := range listeners {
+				fn(old, next)
+			}
+		}
+		return true
+	})
+	go func() {
+		<-ctx.Done()
+		rc.mu.Lock()
+		rc.current = nil
+		rc.mu.Unlock()
+	}()
+}
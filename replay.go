@@ -0,0 +1,214 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// ReplayFormatVersion is the version of the replay format written by ExportReplay
+const ReplayFormatVersion = 1
+
+// replay event types
+const (
+	ReplayHeader = "header"
+	ReplayStep   = "step"
+	ReplayVote   = "vote"
+	ReplayDeath  = "death"
+	ReplayChat   = "chat"
+)
+
+// replayTypeRank orders events of the same time and step, a step starts before what happens in it
+var replayTypeRank = map[string]int{ReplayStep: 0, ReplayVote: 1, ReplayDeath: 2, ReplayChat: 3}
+
+// ReplayEvent is a single line of an exported replay.
+//
+// A replay is newline delimited JSON: the first line is always a "header" event carrying the
+// game bin and format version, followed by "step", "vote", "death" and "chat" events ordered
+// by time with a monotonically increasing seq. Timestamps are unix milliseconds (0 when unknown),
+// events with the same time are ordered by step index, then by type and the key they were read from.
+type ReplayEvent struct {
+	Seq       int    `json:"seq"`
+	Type      string `json:"type"`
+	GameBin   string `json:"game_bin"`
+	TimeStamp int64  `json:"timestamp"`
+	StepBin   string `json:"step_bin,omitempty"`
+	StepType  string `json:"step_type,omitempty"`
+	StepIndex int    `json:"step_index,omitempty"`
+	GamerId   string `json:"gamer_id,omitempty"`
+	Target    string `json:"target,omitempty"`
+	Ability   string `json:"ability,omitempty"`
+	Text      string `json:"text,omitempty"`
+	Version   int    `json:"format_version,omitempty"`
+
+	key string // the node the event was read from, breaks ties between events of the same time
+}
+
+// ExportReplay writes the ordered event log of a game to w. Archived games are preferred over the live tree.
+func (store *Store) ExportReplay(gameId string, w io.Writer) error {
+
+	var game *models.Game
+	var cycles map[string]map[string]map[string][]*models.Result
+	archived, err := store.GetArchivedGame(gameId)
+	if err != nil {
+		return err
+	}
+	if archived != nil && archived.Game != nil {
+		game, cycles = archived.Game, archived.CycleResults
+	} else {
+		game, err = store.getGameByBin(gameId)
+		if err != nil {
+			return err
+		}
+		if game != nil {
+			if cycles, err = store.getAllCycleResults(context.Background(), gameId); err != nil {
+				return err
+			}
+		}
+	}
+	if game == nil {
+		return fmt.Errorf("game not found: %s", gameId)
+	}
+
+	enc := json.NewEncoder(w)
+	if err := enc.Encode(&ReplayEvent{Type: ReplayHeader, GameBin: game.Bin, Version: ReplayFormatVersion}); err != nil {
+		return err
+	}
+
+	for i, e := range buildReplayEvents(game, cycles) {
+		e.Seq = i + 1
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildReplayEvents lists the events of a game, votes of earlier cycles are read from cycles as
+// the steps only hold the current one
+func buildReplayEvents(game *models.Game, cycles map[string]map[string]map[string][]*models.Result) []*ReplayEvent {
+
+	var events []*ReplayEvent
+	stepIndex := make(map[string]int)
+	seen := make(map[string]bool)
+	vote := func(stepBin string, gamerId string, fallback string, r *models.Result) {
+		key := r.Bin
+		if key == "" {
+			key = fallback
+		} else if seen[key] {
+			return
+		}
+		seen[key] = true
+		events = append(events, &ReplayEvent{
+			Type:      ReplayVote,
+			GameBin:   game.Bin,
+			TimeStamp: parseStamp(r.TimeStamp),
+			StepBin:   stepBin,
+			StepIndex: stepIndex[stepBin],
+			GamerId:   gamerId,
+			Target:    r.Vote.Target,
+			Ability:   r.Vote.Ability,
+			key:       key,
+		})
+	}
+
+	for stepBin, step := range game.Steps {
+		if step == nil {
+			continue
+		}
+		stepIndex[stepBin] = step.StepIndex
+		events = append(events, &ReplayEvent{
+			Type:      ReplayStep,
+			GameBin:   game.Bin,
+			TimeStamp: parseStamp(step.StartTime),
+			StepBin:   stepBin,
+			StepType:  step.StepType,
+			StepIndex: step.StepIndex,
+			Text:      step.Command,
+			key:       stepBin,
+		})
+		for gamerId, results := range step.Result {
+			for i, r := range results {
+				if r != nil {
+					vote(stepBin, gamerId, fmt.Sprintf("%s/%s/%06d", stepBin, gamerId, i), r)
+				}
+			}
+		}
+	}
+	for cycle, steps := range cycles {
+		for stepBin, byGamer := range steps {
+			for gamerId, results := range byGamer {
+				for i, r := range results {
+					if r != nil {
+						vote(stepBin, gamerId, fmt.Sprintf("%s/%s/%s/%06d", cycle, stepBin, gamerId, i), r)
+					}
+				}
+			}
+		}
+	}
+
+	for gamerId, gamer := range game.Gamers {
+		if gamer == nil || gamer.IsAlive {
+			continue
+		}
+		e := &ReplayEvent{Type: ReplayDeath, GameBin: game.Bin, GamerId: gamerId, key: gamerId}
+		if gamer.Fate != nil {
+			e.TimeStamp = parseStamp(gamer.Fate.TimeStamp)
+			e.Ability = gamer.Fate.AbilityBin
+		}
+		events = append(events, e)
+	}
+
+	for msgKey, msg := range game.Messages {
+		if msg == nil {
+			continue
+		}
+		e := &ReplayEvent{
+			Type:      ReplayChat,
+			GameBin:   game.Bin,
+			TimeStamp: parseStamp(msg.Timestamp),
+			StepBin:   msg.StepBin,
+			StepIndex: stepIndex[msg.StepBin],
+			GamerId:   msg.Source,
+			Target:    msg.Target,
+			key:       msgKey,
+		}
+		if msg.Payload != nil {
+			e.Text = msg.Payload.Text
+		}
+		events = append(events, e)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].TimeStamp != events[j].TimeStamp {
+			return events[i].TimeStamp < events[j].TimeStamp
+		}
+		if events[i].StepIndex != events[j].StepIndex {
+			return events[i].StepIndex < events[j].StepIndex
+		}
+		if events[i].Type != events[j].Type {
+			return replayTypeRank[events[i].Type] < replayTypeRank[events[j].Type]
+		}
+		return events[i].key < events[j].key
+	})
+	return events
+}
+
+// parseStamp reads either a unix millisecond or an RFC3339 timestamp, returning 0 when it can't
+func parseStamp(s string) int64 {
+	if s == "" {
+		return 0
+	}
+	if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return ms
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.UnixMilli()
+	}
+	return 0
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Report is a complaint filed by one gamer against another, stored under games/{id}/reports
+type Report struct {
+	Bin        string `json:"bin"`
+	GameId     string `json:"game_id"`
+	ReporterId string `json:"reporter_id"`
+	ReportedId string `json:"reported_id"`
+	Reason     string `json:"reason"`
+	TimeStamp  string `json:"timestamp"`
+}
+
+// ReportPlayer files a report against a player in a game
+func (store *Store) ReportPlayer(gameId string, reporterId string, reportedId string, reason string) (*Report, error) {
+
+	if reporterId == "" || reportedId == "" || reporterId == reportedId {
+		return nil, fmt.Errorf("invalid report")
+	}
+
+	r := &Report{
+		Bin:        uuid.New().String(),
+		GameId:     gameId,
+		ReporterId: reporterId,
+		ReportedId: reportedId,
+		Reason:     reason,
+		TimeStamp:  time.Now().UTC().Format(time.RFC3339),
+	}
+	if err := store.SetPath(context.Background(), "games/"+gameId+"/reports/"+r.Bin, r); err != nil {
+		return nil, err
+	}
+	store.publish(&DomainEvent{Type: EventPlayerReported, GameId: gameId, PlayerId: reportedId,
+		Data: map[string]interface{}{"report": r.Bin, "reporter_id": reporterId, "reason": reason}})
+	return r, nil
+}
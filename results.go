@@ -0,0 +1,163 @@
+package v1
+
+import (
+	"context"
+	models "github.com/horcu/pm-models/types"
+	"log"
+	"sort"
+	"time"
+)
+
+// factions a game can be won by when it has no teams
+const (
+	FactionInnocent = "innocent"
+	FactionVillain  = "villain"
+)
+
+// GameResults is the post-game summary written under game_results/{gameId} when the game ends
+type GameResults struct {
+	GameBin         string                  `json:"game_bin"`
+	GroupId         string                  `json:"group_id,omitempty"`
+	WinnerFaction   string                  `json:"winner_faction,omitempty"` // a faction, or the winning team's bin
+	Winners         []string                `json:"winners,omitempty"`        // gamer bins
+	Gamers          map[string]*GamerResult `json:"gamers"`
+	KeyEvents       []*GameAction           `json:"key_events,omitempty"` // deaths and abilities in log order
+	StartTime       string                  `json:"start_time,omitempty"`
+	EndTime         string                  `json:"end_time"`
+	DurationSeconds int64                   `json:"duration_seconds,omitempty"`
+	Cycles          int                     `json:"cycles,omitempty"`
+}
+
+// GamerResult is a gamer's line of the results, revealing the character it played
+type GamerResult struct {
+	Bin           string `json:"bin"`
+	Name          string `json:"name"`
+	CharacterId   string `json:"character_id,omitempty"`
+	CharacterName string `json:"character_name,omitempty"`
+	Faction       string `json:"faction"`
+	Team          string `json:"team,omitempty"`
+	Survived      bool   `json:"survived"`
+	Won           bool   `json:"won"`
+}
+
+// GetGameResults returns the results of an ended game, nil when there are none
+func (store *Store) GetGameResults(gameId string) (*GameResults, error) {
+
+	r := &GameResults{}
+	if err := store.GetPath(context.Background(), "game_results/"+gameId, r); err != nil {
+		return nil, err
+	}
+	if r.GameBin == "" {
+		return nil, nil
+	}
+	return r, nil
+}
+
+// gamerFaction is the faction of the character a gamer plays
+func gamerFaction(g *models.Game, gamer *models.Gamer) string {
+	if c := g.Characters[gamer.CharacterId]; c != nil && c.IsInnocent {
+		return FactionInnocent
+	}
+	return FactionVillain
+}
+
+// buildGameResults computes the results of a game from its final state, teams and action log
+func (store *Store) buildGameResults(g *models.Game, end time.Time) (*GameResults, error) {
+
+	r := &GameResults{
+		GameBin: g.Bin,
+		GroupId: g.GroupId,
+		Gamers:  make(map[string]*GamerResult, len(g.Gamers)),
+		EndTime: FormatTime(end),
+		Cycles:  g.NightCycles,
+	}
+	if g.Sync != nil && g.Sync.StartTime != "" {
+		r.StartTime = g.Sync.StartTime
+		if start, err := ParseTime(g.Sync.StartTime); err == nil && end.After(start) {
+			r.DurationSeconds = int64(end.Sub(start).Seconds())
+		}
+	}
+
+	teams, err := store.GetGameTeams(g.Bin)
+	if err != nil {
+		return nil, err
+	}
+	alive := make(map[string]int)
+	for bin, gamer := range g.Gamers {
+		if gamer == nil {
+			continue
+		}
+		gr := &GamerResult{
+			Bin:         bin,
+			Name:        gamer.Name,
+			CharacterId: gamer.CharacterId,
+			Faction:     gamerFaction(g, gamer),
+			Survived:    gamer.IsAlive,
+		}
+		if c := g.Characters[gamer.CharacterId]; c != nil {
+			gr.CharacterName = c.Name
+		}
+		for teamBin, t := range teams {
+			if t != nil && contains(t.Members, bin) {
+				gr.Team = teamBin
+			}
+		}
+		if gamer.IsAlive {
+			alive[gr.Faction]++
+		}
+		r.Gamers[bin] = gr
+	}
+
+	// the winning team, then the recorded winners, then the surviving factions decide the winner
+	winner, won, err := store.EvaluateTeamWin(g.Bin)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case won:
+		r.WinnerFaction = winner
+	case len(g.Winners) > 0:
+		for _, w := range g.Winners {
+			if w != nil && r.Gamers[w.Bin] != nil {
+				r.WinnerFaction = r.Gamers[w.Bin].Faction
+				break
+			}
+		}
+	case alive[FactionVillain] == 0 && alive[FactionInnocent] > 0:
+		r.WinnerFaction = FactionInnocent
+	case alive[FactionVillain] > 0 && alive[FactionVillain] >= alive[FactionInnocent]:
+		r.WinnerFaction = FactionVillain
+	}
+	for bin, gr := range r.Gamers {
+		gr.Won = r.WinnerFaction != "" && (gr.Team == r.WinnerFaction || gr.Faction == r.WinnerFaction)
+		if gr.Won {
+			r.Winners = append(r.Winners, bin)
+		}
+	}
+	sort.Strings(r.Winners)
+
+	actions, err := store.GetGameActions(g.Bin, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range actions {
+		if a.Type == ActionDeath || a.Type == ActionAbility {
+			r.KeyEvents = append(r.KeyEvents, a)
+		}
+	}
+	return r, nil
+}
+
+// recordGameResults writes the results of a game that just ended, errors are logged so they
+// never keep the game from ending
+func (store *Store) recordGameResults(g *models.Game) {
+
+	r, err := store.buildGameResults(g, time.Now())
+	if err != nil {
+		log.Printf("Error building the results of game %s: %v", g.Bin, err)
+		return
+	}
+	if err := store.SetPath(context.Background(), "game_results/"+g.Bin, r); err != nil {
+		log.Printf("Error writing the results of game %s: %v", g.Bin, err)
+	}
+}
@@ -0,0 +1,125 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"firebase.google.com/go/db"
+	"fmt"
+	"strings"
+)
+
+// revisionField holds a game, group or player document's revision, bumped by the store on every update
+const revisionField = "_rev"
+
+// ErrVersionConflict is returned by versioned updates when the document changed since the expected revision
+var ErrVersionConflict = errors.New("document was updated by someone else")
+
+// bumpRevision returns a copy of a document update with the revision increment added
+func bumpRevision(m map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(m)+1)
+	for k, v := range m {
+		out[k] = v
+	}
+	out[revisionField] = serverIncrement(1)
+	return out
+}
+
+func revisionedPath(dataType string, bin string) (string, error) {
+	switch dataType {
+	case "games", "game_groups", "players":
+		return dataType + "/" + bin, nil
+	default:
+		return "", fmt.Errorf("invalid data type: %s", dataType)
+	}
+}
+
+// GetRevision returns the current revision of a game, group or player, zero if it was never updated
+func (store *Store) GetRevision(dataType string, bin string) (int64, error) {
+
+	path, err := revisionedPath(dataType, bin)
+	if err != nil {
+		return 0, err
+	}
+	var rev int64
+	if err := store.GetPath(context.Background(), path+"/"+revisionField, &rev); err != nil {
+		return 0, err
+	}
+	return rev, nil
+}
+
+// updateAtRevision applies m to an existing document if it is still at expected, checking the
+// revision, applying the fields and bumping the revision in one transaction on the document node
+// so two writers expecting the same revision can't both succeed. m is validated like a plain update.
+func (store *Store) updateAtRevision(dataType string, bin string, expected int64, m map[string]interface{}) error {
+
+	path, err := revisionedPath(dataType, bin)
+	if err != nil {
+		return err
+	}
+	if err := store.validateUpdate(dataType, bin, m); err != nil {
+		return err
+	}
+	// plain JSON values, so the transaction writes exactly what a multi-path update would
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return err
+	}
+
+	return store.TransactionPath(context.Background(), path, func(t db.TransactionNode) (interface{}, error) {
+		var doc map[string]interface{}
+		if err := t.Unmarshal(&doc); err != nil {
+			return nil, err
+		}
+		if doc == nil {
+			return nil, fmt.Errorf("document not found: %s", path)
+		}
+		var current int64
+		if rev, ok := doc[revisionField].(float64); ok {
+			current = int64(rev)
+		}
+		if current != expected {
+			return nil, ErrVersionConflict
+		}
+		for k, v := range fields {
+			setNested(doc, strings.Split(strings.Trim(k, "/"), "/"), v)
+		}
+		doc[revisionField] = expected + 1
+		return doc, nil
+	})
+}
+
+// UpdateGameAtRevision updates a game only if it is still at the expected revision, failing with ErrVersionConflict otherwise
+func (store *Store) UpdateGameAtRevision(b string, expected int64, m map[string]interface{}) error {
+	if err := store.updateAtRevision("games", b, expected, m); err != nil {
+		return err
+	}
+	if touchesSummary(m) {
+		store.refreshGameSummary(b, nil)
+	}
+	return nil
+}
+
+// UpdateGameGroupAtRevision updates a group only if it is still at the expected revision, failing with ErrVersionConflict otherwise
+func (store *Store) UpdateGameGroupAtRevision(b string, expected int64, m map[string]interface{}) error {
+	if err := store.updateAtRevision("game_groups", b, expected, m); err != nil {
+		return err
+	}
+	if touchesDiscovery(m) {
+		store.refreshGroupListing(b)
+	}
+	return nil
+}
+
+// UpdatePlayerAtRevision updates a player only if it is still at the expected revision, failing with ErrVersionConflict otherwise.
+// Status changes have transition rules of their own and go through SetPlayerStatus instead.
+func (store *Store) UpdatePlayerAtRevision(b string, expected int64, m map[string]interface{}) error {
+	if _, ok := m["status"]; ok {
+		return fmt.Errorf("%w: status of player %s can only change through SetPlayerStatus", ErrInvalidStatusTransition, b)
+	}
+	return store.updateAtRevision("players", b, expected, m)
+}
@@ -0,0 +1,62 @@
+package v1_test
+
+import (
+	"context"
+	"errors"
+	models "github.com/horcu/pm-models/types"
+	v1 "github.com/horcu/pm-store"
+	"github.com/horcu/pm-store/testsupport"
+	"testing"
+)
+
+func TestUpdatePlayerAtRevision(t *testing.T) {
+
+	store := testsupport.NewStore(t)
+	if err := store.CreatePlayer(&models.Player{Bin: "alice", UserName: "alice", Status: "available"}); err != nil {
+		t.Fatalf("CreatePlayer: %v", err)
+	}
+
+	if err := store.UpdatePlayerAtRevision("alice", 0, map[string]interface{}{"photo": "a.png"}); err != nil {
+		t.Fatalf("update at the current revision: %v", err)
+	}
+	if err := store.UpdatePlayerAtRevision("alice", 0, map[string]interface{}{"photo": "b.png"}); !errors.Is(err, v1.ErrVersionConflict) {
+		t.Errorf("update at a stale revision: got %v, want ErrVersionConflict", err)
+	}
+	rev, err := store.GetRevision("players", "alice")
+	if err != nil {
+		t.Fatalf("GetRevision: %v", err)
+	}
+	if rev != 1 {
+		t.Errorf("revision is %d, want 1", rev)
+	}
+
+	var invalid *v1.ValidationError
+	if err := store.UpdatePlayerAtRevision("alice", rev, map[string]interface{}{"privacy": "nobody-ever"}); !errors.As(err, &invalid) {
+		t.Errorf("invalid privacy: got %v, want a ValidationError", err)
+	}
+	if err := store.UpdatePlayerAtRevision("alice", rev, map[string]interface{}{"status": "inAGame"}); !errors.Is(err, v1.ErrInvalidStatusTransition) {
+		t.Errorf("status change: got %v, want ErrInvalidStatusTransition", err)
+	}
+	var status string
+	if err := store.GetPath(context.Background(), "players/alice/status", &status); err != nil {
+		t.Fatalf("GetPath: %v", err)
+	}
+	if status != "available" {
+		t.Errorf("status is %q, want it unchanged", status)
+	}
+}
+
+func TestUpdateAtRevisionRefusesMissingDocuments(t *testing.T) {
+
+	store := testsupport.NewStore(t)
+	if err := store.UpdatePlayerAtRevision("ghost", 0, map[string]interface{}{"photo": "a.png"}); err == nil {
+		t.Fatal("updating a missing player succeeded")
+	}
+	var doc map[string]interface{}
+	if err := store.GetPath(context.Background(), "players/ghost", &doc); err != nil {
+		t.Fatalf("GetPath: %v", err)
+	}
+	if doc != nil {
+		t.Errorf("the update created a partial player: %v", doc)
+	}
+}
@@ -0,0 +1,162 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+	"time"
+)
+
+// vote rules
+const (
+	VoteRuleMajority  = "majority"  // more than half of the voters
+	VoteRulePlurality = "plurality" // the most votes, ties are no decision
+	VoteRuleUnanimous = "unanimous" // every voter
+)
+
+// Ruleset holds the configurable rules of a game, stored under games/{id}/ruleset
+type Ruleset struct {
+	VoteRule        string `json:"vote_rule"`
+	AllowRevote     bool   `json:"allow_revote"`
+	AllowSelfVote   bool   `json:"allow_self_vote"`
+	NightLength     string `json:"night_length"` // a time.Duration string e.g. "90s"
+	DayLength       string `json:"day_length"`
+	RevealOnDeath   bool   `json:"reveal_on_death"`
+	WhisperAllowed  bool   `json:"whisper_allowed"`
+	MinPlayers      int    `json:"min_players"`
+	MaxPlayers      int    `json:"max_players"`
+	RequireReady    bool   `json:"require_ready"`              // StartGame waits for every gamer's ready check
+	ReadyTimeout    string `json:"ready_timeout,omitempty"`    // how long WaitAllReady waits before removing unready gamers
+	DisconnectGrace string `json:"disconnect_grace,omitempty"` // how long a disconnected gamer has to come back before being marked afk
+}
+
+// DefaultRuleset returns the rules games are played with when no ruleset was attached
+func DefaultRuleset() *Ruleset {
+	return &Ruleset{
+		VoteRule:       VoteRulePlurality,
+		AllowRevote:    true,
+		AllowSelfVote:  false,
+		NightLength:    "60s",
+		DayLength:      "180s",
+		RevealOnDeath:  true,
+		WhisperAllowed: false,
+		MinPlayers:     4,
+		MaxPlayers:     16,
+	}
+}
+
+// Validate checks that the ruleset is consistent
+func (r *Ruleset) Validate() error {
+
+	var errs []error
+	switch r.VoteRule {
+	case VoteRuleMajority, VoteRulePlurality, VoteRuleUnanimous:
+	default:
+		errs = append(errs, fmt.Errorf("invalid vote rule: %s", r.VoteRule))
+	}
+	if d, err := time.ParseDuration(r.NightLength); err != nil || d <= 0 {
+		errs = append(errs, fmt.Errorf("invalid night length: %s", r.NightLength))
+	}
+	if d, err := time.ParseDuration(r.DayLength); err != nil || d <= 0 {
+		errs = append(errs, fmt.Errorf("invalid day length: %s", r.DayLength))
+	}
+	if r.MinPlayers < 1 {
+		errs = append(errs, fmt.Errorf("min players must be at least 1"))
+	}
+	if r.ReadyTimeout != "" {
+		if d, err := time.ParseDuration(r.ReadyTimeout); err != nil || d <= 0 {
+			errs = append(errs, fmt.Errorf("invalid ready timeout: %s", r.ReadyTimeout))
+		}
+	}
+	if r.DisconnectGrace != "" {
+		if d, err := time.ParseDuration(r.DisconnectGrace); err != nil || d <= 0 {
+			errs = append(errs, fmt.Errorf("invalid disconnect grace: %s", r.DisconnectGrace))
+		}
+	}
+	if r.MaxPlayers < r.MinPlayers {
+		errs = append(errs, fmt.Errorf("max players must be at least min players"))
+	}
+	return errors.Join(errs...)
+}
+
+// NightDuration returns the parsed night length
+func (r *Ruleset) NightDuration() time.Duration {
+	d, _ := time.ParseDuration(r.NightLength)
+	return d
+}
+
+// DayDuration returns the parsed day length
+func (r *Ruleset) DayDuration() time.Duration {
+	d, _ := time.ParseDuration(r.DayLength)
+	return d
+}
+
+// CanVote reports whether the vote is allowed given the results the source already has in the step
+func (r *Ruleset) CanVote(vote *models.Vote, previous []*models.Result) error {
+
+	if !r.AllowSelfVote && vote.Target != "" && vote.Target == vote.Source {
+		return errors.New("self votes are not allowed")
+	}
+	if !r.AllowRevote && len(previous) > 0 {
+		return errors.New("gamer has already voted in this step")
+	}
+	return nil
+}
+
+// VoteWinner applies the vote rule to a tally of target and votes. It returns the
+// winning target and false when the vote did not reach a decision.
+func (r *Ruleset) VoteWinner(tally map[string]int, voters int) (string, bool) {
+
+	var winner string
+	var best, total int
+	tie := false
+	for target, n := range tally {
+		total += n
+		if n > best {
+			winner, best, tie = target, n, false
+		} else if n == best {
+			tie = true
+		}
+	}
+	if winner == "" || tie {
+		return "", false
+	}
+	if voters < total {
+		voters = total
+	}
+
+	switch r.VoteRule {
+	case VoteRuleMajority:
+		return winner, best*2 > voters
+	case VoteRuleUnanimous:
+		return winner, best == voters
+	default:
+		return winner, true
+	}
+}
+
+// SetGameRuleset validates and attaches a ruleset to a game
+func (store *Store) SetGameRuleset(gameId string, r *Ruleset) error {
+
+	if r == nil {
+		return fmt.Errorf("invalid ruleset object")
+	}
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	return store.SetPath(context.Background(), "games/"+gameId+"/ruleset", r)
+}
+
+// GetGameRuleset returns the ruleset of a game, falling back to DefaultRuleset
+func (store *Store) GetGameRuleset(gameId string) (*Ruleset, error) {
+
+	var r *Ruleset
+	if err := store.GetPath(context.Background(), "games/"+gameId+"/ruleset", &r); err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return DefaultRuleset(), nil
+	}
+	return r, nil
+}
@@ -0,0 +1,388 @@
+package v1
+
+import (
+	"context"
+	"firebase.google.com/go/db"
+	"fmt"
+	"github.com/google/uuid"
+	models "github.com/horcu/pm-models/types"
+	"log"
+	"time"
+)
+
+// scheduled game states
+const (
+	ScheduleScheduled = "scheduled"
+	ScheduleStarting  = "starting"
+	ScheduleCreated   = "created"
+	ScheduleCancelled = "cancelled"
+)
+
+// RSVP answers
+const (
+	RSVPYes   = "yes"
+	RSVPNo    = "no"
+	RSVPMaybe = "maybe"
+)
+
+// scheduleStartAttempts is how often a failing start is tried before the schedule is cancelled
+const scheduleStartAttempts = 3
+
+// scheduleRetryDelay is the wait before a failed start is tried again
+const scheduleRetryDelay = time.Minute
+
+// ScheduleSettings describes the game a schedule creates
+type ScheduleSettings struct {
+	TemplateId     string   `json:"template_id,omitempty"` // instantiate this template, otherwise an empty game
+	Ruleset        *Ruleset `json:"ruleset,omitempty"`
+	Quorum         int      `json:"quorum"`                    // yes RSVPs needed for the game to be created
+	ReminderBefore string   `json:"reminder_before,omitempty"` // a time.Duration string, empty sends no reminder
+}
+
+// ScheduledGame is a game planned by a group, stored under scheduled_games/{bin}. RemindAt and
+// PendingStart are cleared once handled so the due queries only see outstanding work, a start that
+// fails sets PendingStart again to be retried.
+type ScheduledGame struct {
+	Bin          string            `json:"bin"`
+	GroupId      string            `json:"group_id"`
+	CreatorId    string            `json:"creator_id"`
+	StartAt      int64             `json:"start_at"` // unix seconds
+	Settings     *ScheduleSettings `json:"settings"`
+	Status       string            `json:"status"`
+	RSVPs        map[string]string `json:"rsvps,omitempty"`
+	RemindAt     int64             `json:"remind_at,omitempty"`
+	PendingStart int64             `json:"pending_start,omitempty"`
+	GameId       string            `json:"game_id,omitempty"`
+	Attempts     int               `json:"attempts,omitempty"`      // failed starts
+	RecurrenceId string            `json:"recurrence_id,omitempty"` // the group recurrence that materialized it
+	Timezone     string            `json:"timezone,omitempty"`      // the creator's, for showing the local start
+	CreatedAt    string            `json:"created_at"`
+}
+
+// LocalStart returns the start time in the creator's timezone
+func (s *ScheduledGame) LocalStart() time.Time {
+
+	t := time.Unix(s.StartAt, 0)
+	if loc, err := loadTimezone(s.Timezone); err == nil {
+		return t.In(loc)
+	}
+	return t.UTC()
+}
+
+// ScheduleGame plans a game for a group, any admin or the owner can schedule
+func (store *Store) ScheduleGame(groupId string, creatorId string, startAt time.Time, settings *ScheduleSettings) (*ScheduledGame, error) {
+	return store.scheduleGame(groupId, creatorId, startAt, settings, "")
+}
+
+func (store *Store) scheduleGame(groupId string, creatorId string, startAt time.Time, settings *ScheduleSettings, recurrenceId string) (*ScheduledGame, error) {
+
+	if settings == nil {
+		settings = &ScheduleSettings{}
+	}
+	if settings.Ruleset != nil {
+		if err := settings.Ruleset.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if !startAt.After(time.Now()) {
+		return nil, fmt.Errorf("scheduled games must start in the future")
+	}
+	if _, err := store.requireRole(groupId, creatorId, RoleAdmin); err != nil {
+		return nil, err
+	}
+	tz, err := store.GetPlayerTimezone(creatorId)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &ScheduledGame{
+		Bin:          uuid.New().String(),
+		GroupId:      groupId,
+		CreatorId:    creatorId,
+		StartAt:      startAt.Unix(),
+		Settings:     settings,
+		Status:       ScheduleScheduled,
+		RSVPs:        map[string]string{creatorId: RSVPYes},
+		PendingStart: startAt.Unix(),
+		RecurrenceId: recurrenceId,
+		Timezone:     tz,
+		CreatedAt:    time.Now().UTC().Format(time.RFC3339),
+	}
+	if d, err := time.ParseDuration(settings.ReminderBefore); err == nil && d > 0 {
+		s.RemindAt = startAt.Add(-d).Unix()
+	}
+	if err := store.SetPath(context.Background(), "scheduled_games/"+s.Bin, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// GetScheduledGame returns a scheduled game, nil when it doesn't exist
+func (store *Store) GetScheduledGame(bin string) (*ScheduledGame, error) {
+
+	s := &ScheduledGame{}
+	if err := store.GetPath(context.Background(), "scheduled_games/"+bin, s); err != nil {
+		return nil, err
+	}
+	if s.Bin == "" {
+		return nil, nil
+	}
+	return s, nil
+}
+
+// GetGroupSchedules returns the scheduled games of a group
+func (store *Store) GetGroupSchedules(groupId string) ([]*ScheduledGame, error) {
+
+	q := store.NewRef("scheduled_games").OrderByChild("group_id").EqualTo(groupId)
+	nodes, err := store.GetOrderedQuery(context.Background(), "scheduled_games", q)
+	if err != nil {
+		return nil, err
+	}
+	var schedules []*ScheduledGame
+	for _, n := range nodes {
+		s := &ScheduledGame{}
+		if err := n.Unmarshal(s); err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, s)
+	}
+	return schedules, nil
+}
+
+// RSVP records a group member's answer to a scheduled game
+func (store *Store) RSVP(scheduleId string, playerId string, answer string) error {
+
+	switch answer {
+	case RSVPYes, RSVPNo, RSVPMaybe:
+	default:
+		return fmt.Errorf("invalid rsvp: %s", answer)
+	}
+	s, err := store.GetScheduledGame(scheduleId)
+	if err != nil {
+		return err
+	}
+	if s == nil || s.Status != ScheduleScheduled {
+		return fmt.Errorf("scheduled game not open: %s", scheduleId)
+	}
+	if _, err := store.requireRole(s.GroupId, playerId, RoleMember); err != nil {
+		return err
+	}
+	return store.SetPath(context.Background(), "scheduled_games/"+scheduleId+"/rsvps/"+playerId, answer)
+}
+
+// CancelScheduledGame cancels a scheduled game on behalf of an admin or the owner
+func (store *Store) CancelScheduledGame(scheduleId string, actorId string) error {
+
+	s, err := store.GetScheduledGame(scheduleId)
+	if err != nil {
+		return err
+	}
+	if s == nil {
+		return fmt.Errorf("scheduled game not found: %s", scheduleId)
+	}
+	if _, err := store.requireRole(s.GroupId, actorId, RoleAdmin); err != nil {
+		return err
+	}
+	return store.finishSchedule(s, ScheduleCancelled, "", "cancelled by "+actorId)
+}
+
+// RunScheduler processes due schedules every interval until ctx is done
+func (store *Store) RunScheduler(ctx context.Context, interval time.Duration) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := store.ProcessDueSchedules(time.Now()); err != nil {
+			log.Printf("Error processing scheduled games: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// dueSchedules returns the schedules whose child field is set and not after now
+func (store *Store) dueSchedules(field string, now time.Time) ([]*ScheduledGame, error) {
+
+	q := store.NewRef("scheduled_games").OrderByChild(field).StartAt(1).EndAt(now.Unix())
+	nodes, err := store.GetOrderedQuery(context.Background(), "scheduled_games", q)
+	if err != nil {
+		return nil, err
+	}
+	var due []*ScheduledGame
+	for _, n := range nodes {
+		s := &ScheduledGame{}
+		if err := n.Unmarshal(s); err != nil {
+			return nil, err
+		}
+		due = append(due, s)
+	}
+	return due, nil
+}
+
+// claimSchedule clears a due field in a transaction and reports whether this caller cleared it,
+// so several scheduler instances never handle the same schedule twice
+func (store *Store) claimSchedule(bin string, field string) (bool, error) {
+
+	claimed := false
+	err := store.TransactionPath(context.Background(), "scheduled_games/"+bin+"/"+field, func(t db.TransactionNode) (interface{}, error) {
+		var at int64
+		if err := t.Unmarshal(&at); err != nil {
+			return nil, err
+		}
+		claimed = at != 0
+		return nil, nil
+	})
+	return claimed, err
+}
+
+// ProcessDueSchedules sends due reminders, creates or cancels the games due to start and expires
+// disconnect grace periods
+func (store *Store) ProcessDueSchedules(now time.Time) error {
+
+	reminders, err := store.dueSchedules("remind_at", now)
+	if err != nil {
+		return err
+	}
+	for _, s := range reminders {
+		if ok, err := store.claimSchedule(s.Bin, "remind_at"); err != nil || !ok {
+			continue
+		}
+		store.publish(&DomainEvent{Type: EventScheduleReminder, GroupId: s.GroupId,
+			Data: map[string]interface{}{"schedule": s.Bin, "start_at": s.StartAt}})
+	}
+
+	starts, err := store.dueSchedules("pending_start", now)
+	if err != nil {
+		return err
+	}
+	for _, s := range starts {
+		if ok, err := store.claimSchedule(s.Bin, "pending_start"); err != nil || !ok {
+			continue
+		}
+		if err := store.startScheduledGame(s); err != nil {
+			log.Printf("Error starting scheduled game %s: %v", s.Bin, err)
+			store.retrySchedule(s, err)
+		}
+	}
+	return store.processDisconnects(now)
+}
+
+// startScheduledGame creates the game of a due schedule when the quorum is met, with the yes
+// RSVPs joined as gamers, and cancels it otherwise
+func (store *Store) startScheduledGame(s *ScheduledGame) error {
+
+	var yes []string
+	for playerId, answer := range s.RSVPs {
+		if answer == RSVPYes {
+			yes = append(yes, playerId)
+		}
+	}
+	if s.Settings == nil {
+		s.Settings = &ScheduleSettings{}
+	}
+	if len(yes) < s.Settings.Quorum {
+		return store.finishSchedule(s, ScheduleCancelled, "", fmt.Sprintf("quorum not met: %d of %d", len(yes), s.Settings.Quorum))
+	}
+	if err := store.UpdatePath(context.Background(), "scheduled_games/"+s.Bin, map[string]interface{}{"status": ScheduleStarting}); err != nil {
+		return err
+	}
+
+	creator := &models.Player{}
+	if err := store.GetPath(context.Background(), "players/"+s.CreatorId, creator); err != nil {
+		return err
+	}
+
+	var g *models.Game
+	var err error
+	switch {
+	case s.GameId != "":
+		// an earlier attempt created the game before failing
+		g = &models.Game{Bin: s.GameId}
+	case s.Settings.TemplateId != "":
+		g, err = store.InstantiateGameFromTemplate(s.Settings.TemplateId, creator, s.GroupId)
+	default:
+		g = &models.Game{Bin: uuid.New().String(), GroupId: s.GroupId, Status: "waiting", Creator: creator}
+		err = store.CreateGame(g)
+	}
+	if err != nil {
+		return err
+	}
+	if s.GameId == "" {
+		if err := store.UpdatePath(context.Background(), "scheduled_games/"+s.Bin, map[string]interface{}{"game_id": g.Bin}); err != nil {
+			return err
+		}
+		s.GameId = g.Bin
+	}
+	if s.Settings.Ruleset != nil {
+		if err := store.SetGameRuleset(g.Bin, s.Settings.Ruleset); err != nil {
+			return err
+		}
+	}
+
+	gamers := make(map[string]interface{}, len(yes))
+	for _, playerId := range yes {
+		p := &models.Player{}
+		if err := store.GetPath(context.Background(), "players/"+playerId, p); err != nil {
+			return err
+		}
+		gamers[playerId] = &models.Gamer{Bin: playerId, GameId: g.Bin, Name: p.UserName, ImageUrl: p.Photo, IsAlive: true}
+	}
+	if err := store.UpdateGamersInGame(g.Bin, gamers); err != nil {
+		return err
+	}
+	return store.finishSchedule(s, ScheduleCreated, g.Bin, "")
+}
+
+// retrySchedule hands a schedule whose start failed back to the due query after the retry delay,
+// cancelling it once it runs out of attempts
+func (store *Store) retrySchedule(s *ScheduledGame, cause error) {
+
+	s.Attempts++
+	if s.Attempts >= scheduleStartAttempts {
+		if err := store.finishSchedule(s, ScheduleCancelled, s.GameId, fmt.Sprintf("start failed: %v", cause)); err != nil {
+			log.Printf("Error cancelling scheduled game %s: %v", s.Bin, err)
+		}
+		return
+	}
+	err := store.UpdatePath(context.Background(), "scheduled_games/"+s.Bin, map[string]interface{}{
+		"status":        ScheduleScheduled,
+		"attempts":      s.Attempts,
+		"pending_start": time.Now().Add(scheduleRetryDelay).Unix(),
+	})
+	if err != nil {
+		log.Printf("Error rescheduling the start of %s: %v", s.Bin, err)
+	}
+}
+
+func (store *Store) finishSchedule(s *ScheduledGame, status string, gameId string, reason string) error {
+
+	m := map[string]interface{}{
+		"status":        status,
+		"remind_at":     nil,
+		"pending_start": nil,
+	}
+	if gameId != "" {
+		m["game_id"] = gameId
+	}
+	if err := store.UpdatePath(context.Background(), "scheduled_games/"+s.Bin, m); err != nil {
+		return err
+	}
+
+	e := &DomainEvent{Type: EventScheduleStarted, GameId: gameId, GroupId: s.GroupId, Data: map[string]interface{}{"schedule": s.Bin}}
+	if status == ScheduleCancelled {
+		e.Type = EventScheduleCancelled
+		e.Data["reason"] = reason
+	}
+	store.publish(e)
+
+	// a skipped occurrence of a recurrence still schedules the one after it
+	if status == ScheduleCancelled && s.RecurrenceId != "" {
+		if err := store.scheduleNext(s); err != nil {
+			log.Printf("Error scheduling the next occurrence of %s: %v", s.RecurrenceId, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// SchemaVersion is the record shape version written to the _v field of every record written whole,
+// created or migrated. Partial updates leave _v alone so older records still get upconverted on
+// read. Bump it together with a new upconverter whenever a model changes shape.
+const SchemaVersion = 1
+
+// schemaField is the node holding the schema version of a record
+const schemaField = "_v"
+
+// upconverter rewrites a raw record of one version into the next version's shape in place
+type upconverter func(m map[string]interface{}) error
+
+// upconverters holds, per data type, the converter from each version to the next one
+var upconverters = map[string]map[int]upconverter{
+	"games": {
+		0: func(m map[string]interface{}) error {
+			// v0 games kept the start and end times at the top level instead of under sync
+			sync, _ := m["sync"].(map[string]interface{})
+			if sync == nil {
+				sync = make(map[string]interface{})
+			}
+			for _, k := range []string{"start_time", "end_time"} {
+				if v, ok := m[k]; ok {
+					if _, set := sync[k]; !set {
+						sync[k] = v
+					}
+					delete(m, k)
+				}
+			}
+			if len(sync) > 0 {
+				m["sync"] = sync
+			}
+			return nil
+		},
+	},
+}
+
+// recordVersion returns the schema version of a raw record, records written before versioning are version 0
+func recordVersion(m map[string]interface{}) int {
+	if v, ok := m[schemaField].(float64); ok {
+		return int(v)
+	}
+	return 0
+}
+
+// withVersion converts a model into a raw record stamped with the current schema version
+func withVersion(v interface{}) (map[string]interface{}, error) {
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("error versioning record: %v", err)
+	}
+	if m == nil {
+		m = make(map[string]interface{})
+	}
+	m[schemaField] = SchemaVersion
+	return m, nil
+}
+
+// upconvert rewrites a raw record of the given data type to the current shape in place
+func upconvert(dataType string, m map[string]interface{}) error {
+
+	version := recordVersion(m)
+	if version > SchemaVersion {
+		return fmt.Errorf("%s record has schema version %d, newer than supported %d", dataType, version, SchemaVersion)
+	}
+	for ; version < SchemaVersion; version++ {
+		if up, ok := upconverters[dataType][version]; ok {
+			if err := up(m); err != nil {
+				return fmt.Errorf("error upgrading %s record from version %d: %v", dataType, version, err)
+			}
+		}
+	}
+	m[schemaField] = SchemaVersion
+	return nil
+}
+
+// decodeVersioned up-converts a raw record of the given data type to the current shape and decodes it into v
+func decodeVersioned(dataType string, raw interface{}, v interface{}) error {
+
+	m, ok := raw.(map[string]interface{})
+	if !ok || m == nil {
+		return nil
+	}
+	if err := upconvert(dataType, m); err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// MigrateRecords rewrites every record of a data type in the current schema version, returning how many were rewritten
+func (store *Store) MigrateRecords(dataType string) (int, error) {
+
+	if _, ok := map[string]bool{"players": true, "game_groups": true, "games": true, "characters": true, "abilities": true}[dataType]; !ok {
+		return 0, fmt.Errorf("invalid data type: %s", dataType)
+	}
+
+	bins, err := store.GetShallowKeys(dataType)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, bin := range bins {
+		var raw map[string]interface{}
+		if err := store.GetPath(context.Background(), dataType+"/"+bin, &raw); err != nil {
+			return n, err
+		}
+		if raw == nil || recordVersion(raw) == SchemaVersion {
+			continue
+		}
+		// convert the raw record so children the models don't know about are kept
+		if err := upconvert(dataType, raw); err != nil {
+			return n, err
+		}
+		if err := store.SetPath(context.Background(), dataType+"/"+bin, raw); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
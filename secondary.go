@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"context"
+	"firebase.google.com/go/db"
+	"log"
+	"sync"
+)
+
+type secondaryKey struct{}
+
+// secondary is a mirrored instance serving heavy read-only scans
+type secondary struct {
+	url    string
+	once   sync.Once
+	client *db.Client
+	err    error
+}
+
+// WithSecondary sets a mirrored database instance that reads made with an OnSecondary context go
+// to, keeping exports and analytics scans off the primary
+func WithSecondary(url string) StoreOption {
+	return func(store *Store) {
+		if url != "" {
+			store.secondary = &secondary{url: url}
+		}
+	}
+}
+
+// OnSecondary returns a context whose reads go to the store's secondary instance when it has one.
+// Writes always go to the primary, and the mirror may lag behind it.
+func OnSecondary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, secondaryKey{}, true)
+}
+
+// readRef returns the reference a read of path made with ctx uses, on the secondary when ctx asks
+// for it. Paths of sharded games stay on their shard, the secondary only mirrors the primary.
+func (store *Store) readRef(ctx context.Context, path string) (*db.Ref, error) {
+
+	client, err := store.clientFor(path)
+	if err != nil {
+		return nil, err
+	}
+	on, _ := ctx.Value(secondaryKey{}).(bool)
+	if !on || store.secondary == nil || client != store.Client {
+		return client.NewRef(store.fullPath(path)), nil
+	}
+	s := store.secondary
+	s.once.Do(func() {
+		if store.app == nil {
+			return
+		}
+		s.client, s.err = store.app.DatabaseWithURL(context.Background(), s.url)
+	})
+	if s.client == nil {
+		if s.err != nil {
+			log.Printf("Error connecting to secondary %s, using the primary: %v", s.url, s.err)
+		}
+		return client.NewRef(store.fullPath(path)), nil
+	}
+	return s.client.NewRef(store.fullPath(path)), nil
+}
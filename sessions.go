@@ -0,0 +1,129 @@
+package v1
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DefaultSessionTTL is how long a session stays valid without being used
+const DefaultSessionTTL = 30 * 24 * time.Hour
+
+// ErrInvalidSession is returned for unknown, revoked or expired session tokens
+var ErrInvalidSession = errors.New("invalid session")
+
+// Session is a signed in device of a player, stored under players/{id}/sessions/{sessionId}. The
+// session id is a hash of the token so the token itself is never stored.
+type Session struct {
+	Id        string `json:"id"`
+	Device    string `json:"device"`
+	CreatedAt string `json:"created_at"`
+	LastSeen  string `json:"last_seen"`
+	ExpiresAt int64  `json:"expires_at"` // unix seconds
+}
+
+func sessionId(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:16])
+}
+
+// CreateSession starts a session for a player's device and returns its token
+func (store *Store) CreateSession(playerId string, device string) (string, *Session, error) {
+
+	if playerId == "" {
+		return "", nil, fmt.Errorf("a player id is required")
+	}
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, err
+	}
+	token := hex.EncodeToString(b)
+
+	now := time.Now()
+	s := &Session{
+		Id:        sessionId(token),
+		Device:    device,
+		CreatedAt: FormatTime(now),
+		LastSeen:  FormatTime(now),
+		ExpiresAt: now.Add(DefaultSessionTTL).Unix(),
+	}
+	if err := store.SetPath(context.Background(), "players/"+playerId+"/sessions/"+s.Id, s); err != nil {
+		return "", nil, err
+	}
+	return token, s, nil
+}
+
+// ValidateSession returns the session behind a token and extends it, ErrInvalidSession when the
+// token is unknown or expired
+func (store *Store) ValidateSession(playerId string, token string) (*Session, error) {
+
+	ctx := context.Background()
+	path := "players/" + playerId + "/sessions/" + sessionId(token)
+	var s *Session
+	if err := store.GetPath(ctx, path, &s); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if s == nil {
+		return nil, ErrInvalidSession
+	}
+	if s.ExpiresAt <= now.Unix() {
+		_ = store.DeletePath(ctx, path)
+		return nil, ErrInvalidSession
+	}
+
+	s.LastSeen = FormatTime(now)
+	s.ExpiresAt = now.Add(DefaultSessionTTL).Unix()
+	err := store.UpdatePath(ctx, path, map[string]interface{}{
+		"last_seen":  s.LastSeen,
+		"expires_at": s.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// RevokeSession ends one session of a player
+func (store *Store) RevokeSession(playerId string, id string) error {
+	return store.DeletePath(context.Background(), "players/"+playerId+"/sessions/"+id)
+}
+
+// RevokeOtherSessions ends every session of a player but keep, for single active session sign in
+func (store *Store) RevokeOtherSessions(playerId string, keep string) error {
+
+	ids, err := store.GetShallowKeys("players/" + playerId + "/sessions")
+	if err != nil {
+		return err
+	}
+	m := make(map[string]interface{}, len(ids))
+	for _, id := range ids {
+		if id != keep {
+			m[id] = nil
+		}
+	}
+	if len(m) == 0 {
+		return nil
+	}
+	return store.UpdatePath(context.Background(), "players/"+playerId+"/sessions", m)
+}
+
+// GetActiveSessions returns the unexpired sessions of a player, e.g. to list their devices
+func (store *Store) GetActiveSessions(playerId string) (map[string]*Session, error) {
+
+	var m map[string]*Session
+	if err := store.GetPath(context.Background(), "players/"+playerId+"/sessions", &m); err != nil {
+		return nil, err
+	}
+	now := time.Now().Unix()
+	for id, s := range m {
+		if s == nil || s.ExpiresAt <= now {
+			delete(m, id)
+		}
+	}
+	return m, nil
+}
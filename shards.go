@@ -0,0 +1,261 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"firebase.google.com/go/db"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync"
+)
+
+// shardRouter sends game scoped paths to the database instance holding the game. The shard map
+// under shard_map/{gameId} lives on the primary instance; games without an entry stay on the primary.
+type shardRouter struct {
+	mu      sync.RWMutex
+	urls    []string              // instances new games are spread over
+	clients map[string]*db.Client // clients by database url
+	games   map[string]string     // cached shard map, "" means the primary
+}
+
+func newShardRouter(urls []string) *shardRouter {
+	return &shardRouter{
+		urls:    urls,
+		clients: make(map[string]*db.Client),
+		games:   make(map[string]string),
+	}
+}
+
+// WithShards spreads newly created games over the given database instances
+func WithShards(urls ...string) StoreOption {
+	return func(store *Store) {
+		if store.shards == nil {
+			store.shards = newShardRouter(urls)
+		} else {
+			store.shards.urls = urls
+		}
+	}
+}
+
+// gameIdOf returns the game id of a games/{id}/... path
+func gameIdOf(path string) string {
+	parts := strings.SplitN(strings.Trim(path, "/"), "/", 3)
+	if len(parts) < 2 || parts[0] != "games" {
+		return ""
+	}
+	return parts[1]
+}
+
+// clientFor returns the client of the instance holding path. A failed shard lookup is returned
+// rather than guessed, so a sharded game's writes never land on the primary.
+func (store *Store) clientFor(path string) (*db.Client, error) {
+
+	if store.shards == nil {
+		return store.Client, nil
+	}
+	gameId := gameIdOf(path)
+	if gameId == "" {
+		return store.Client, nil
+	}
+	url, err := store.GameShard(gameId)
+	if err != nil {
+		return nil, fmt.Errorf("error looking up shard of game %s: %v", gameId, err)
+	}
+	if url == "" {
+		return store.Client, nil
+	}
+	client, err := store.shardClient(url)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to shard %s: %v", url, err)
+	}
+	return client, nil
+}
+
+// ref returns a reference to path on the instance holding it
+func (store *Store) ref(path string) (*db.Ref, error) {
+
+	client, err := store.clientFor(path)
+	if err != nil {
+		return nil, err
+	}
+	return client.NewRef(store.fullPath(path)), nil
+}
+
+// isShardedScan reports whether a read of path covers games spread over several instances
+func (store *Store) isShardedScan(path string) bool {
+	return store.shards != nil && strings.Trim(path, "/") == "games"
+}
+
+// shardClients returns the client of every shard games are or were spread over, without the primary
+func (store *Store) shardClients() ([]*db.Client, error) {
+
+	r := store.shards
+	r.mu.RLock()
+	urls := append([]string(nil), r.urls...)
+	for url := range r.clients {
+		if !contains(urls, url) {
+			urls = append(urls, url)
+		}
+	}
+	r.mu.RUnlock()
+
+	var clients []*db.Client
+	for _, url := range urls {
+		client, err := store.shardClient(url)
+		if err != nil {
+			return nil, fmt.Errorf("error connecting to shard %s: %v", url, err)
+		}
+		if client != store.Client {
+			clients = append(clients, client)
+		}
+	}
+	return clients, nil
+}
+
+// getAcrossShards reads the games collection from the primary ref and every shard and merges
+// them into v, shallow reads only the game ids
+func (store *Store) getAcrossShards(ctx context.Context, primary *db.Ref, path string, shallow bool, v interface{}) error {
+
+	refs := []*db.Ref{primary}
+	clients, err := store.shardClients()
+	if err != nil {
+		return err
+	}
+	for _, client := range clients {
+		refs = append(refs, client.NewRef(store.fullPath(path)))
+	}
+
+	merged := make(map[string]json.RawMessage)
+	for _, ref := range refs {
+		var m map[string]json.RawMessage
+		if shallow {
+			err = ref.GetShallow(ctx, &m)
+		} else {
+			err = ref.Get(ctx, &m)
+		}
+		if err != nil {
+			return err
+		}
+		for k, raw := range m {
+			merged[k] = raw
+		}
+	}
+	if len(merged) == 0 {
+		return nil
+	}
+	raw, err := json.Marshal(merged)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
+
+func (store *Store) shardClient(url string) (*db.Client, error) {
+
+	r := store.shards
+	r.mu.RLock()
+	client, ok := r.clients[url]
+	r.mu.RUnlock()
+	if ok {
+		return client, nil
+	}
+
+	if store.app == nil {
+		return nil, fmt.Errorf("store is not connected")
+	}
+	client, err := store.app.DatabaseWithURL(context.Background(), url)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	r.clients[url] = client
+	r.mu.Unlock()
+	return client, nil
+}
+
+// GameShard returns the database url holding a game, empty for the primary
+func (store *Store) GameShard(gameId string) (string, error) {
+
+	if store.shards == nil {
+		return "", nil
+	}
+	r := store.shards
+	r.mu.RLock()
+	url, ok := r.games[gameId]
+	r.mu.RUnlock()
+	if ok {
+		return url, nil
+	}
+
+	// the shard map is read from the primary directly so lookups never route themselves
+	if err := store.Client.NewRef(store.fullPath("shard_map/"+gameId)).Get(context.Background(), &url); err != nil {
+		return "", err
+	}
+	r.mu.Lock()
+	r.games[gameId] = url
+	r.mu.Unlock()
+	return url, nil
+}
+
+// AssignGameShard pins a game to a database instance, empty url pins it to the primary
+func (store *Store) AssignGameShard(gameId string, url string) error {
+
+	if store.shards == nil {
+		return fmt.Errorf("store has no shards configured")
+	}
+	// shard_map is not game scoped, so the helpers write it to the primary
+	var err error
+	if url == "" {
+		err = store.DeletePath(context.Background(), "shard_map/"+gameId)
+	} else {
+		err = store.SetPath(context.Background(), "shard_map/"+gameId, url)
+	}
+	if err != nil {
+		return err
+	}
+	store.shards.mu.Lock()
+	store.shards.games[gameId] = url
+	store.shards.mu.Unlock()
+	return nil
+}
+
+// assignNewGame picks the shard of a new game by hashing its id, keeping any existing assignment
+func (store *Store) assignNewGame(gameId string) error {
+
+	if store.shards == nil || len(store.shards.urls) == 0 {
+		return nil
+	}
+	current, err := store.GameShard(gameId)
+	if err != nil {
+		return err
+	}
+	if current != "" {
+		return nil
+	}
+	h := fnv.New32a()
+	h.Write([]byte(gameId))
+	return store.AssignGameShard(gameId, store.shards.urls[int(h.Sum32())%len(store.shards.urls)])
+}
+
+// updateShardedRoot applies a root multi-path update, one update per instance
+func (store *Store) updateShardedRoot(ctx context.Context, m map[string]interface{}) error {
+
+	groups := make(map[*db.Client]map[string]interface{})
+	for k, v := range m {
+		client, err := store.clientFor(k)
+		if err != nil {
+			return err
+		}
+		if groups[client] == nil {
+			groups[client] = make(map[string]interface{})
+		}
+		groups[client][k] = v
+	}
+	for client, g := range groups {
+		if err := client.NewRef(store.fullPath("/")).Update(ctx, g); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+)
+
+// SlowOp describes a database call that took longer than the store's slow operation threshold
+type SlowOp struct {
+	Op       string
+	Path     string
+	Duration time.Duration
+	Size     int // bytes of the json written or read, zero when unknown
+	Err      error
+}
+
+// WithSlowOpThreshold logs every database call taking longer than threshold with its operation,
+// path, duration and payload size, and hands it to report when given, e.g. to sample into an APM
+func WithSlowOpThreshold(threshold time.Duration, report func(*SlowOp)) StoreOption {
+	return func(store *Store) {
+		store.slowThreshold = threshold
+		store.slowReport = report
+	}
+}
+
+// timeOp reports the call started at start when it was slow. v is the payload written or the
+// value read into, it's only measured for slow calls.
+func (store *Store) timeOp(op string, path string, v interface{}, start time.Time, err error) {
+
+	if store.slowThreshold <= 0 {
+		return
+	}
+	d := time.Since(start)
+	if d < store.slowThreshold {
+		return
+	}
+
+	s := &SlowOp{Op: op, Path: path, Duration: d, Err: err}
+	if v != nil {
+		if b, err := json.Marshal(v); err == nil {
+			s.Size = len(b)
+		}
+	}
+	log.Printf("Slow %s of %s took %v (%d bytes)", op, store.fullPath(path), d, s.Size)
+	if store.slowReport != nil {
+		store.slowReport(s)
+	}
+}
@@ -0,0 +1,173 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+	"time"
+)
+
+// ChatChannelSpectators is the channel of spectators, stored under games/{id}/spectator_chat
+const ChatChannelSpectators = "spectators"
+
+// Spectator is a player watching a game they don't play in, under games/{id}/spectators/{playerId}
+type Spectator struct {
+	PlayerId string `json:"player_id"`
+	UserName string `json:"user_name"`
+	JoinedAt string `json:"joined_at"`
+}
+
+// AddSpectator lets a player who isn't a gamer watch a game, checking bans and blocks
+func (store *Store) AddSpectator(gameId string, playerId string) error {
+
+	ctx := context.Background()
+	var status string
+	if err := store.GetPath(ctx, "games/"+gameId+"/status", &status); err != nil {
+		return err
+	}
+	if status == "" {
+		return fmt.Errorf("game not found: %s", gameId)
+	}
+	var banned bool
+	if err := store.GetPath(ctx, "games/"+gameId+"/bans/"+playerId, &banned); err != nil {
+		return err
+	}
+	if banned {
+		return fmt.Errorf("player %s is banned from game %s", playerId, gameId)
+	}
+	gamers, err := store.GetShallowKeys("games/" + gameId + "/gamers")
+	if err != nil {
+		return err
+	}
+	if contains(gamers, playerId) {
+		return fmt.Errorf("player %s plays in game %s", playerId, gameId)
+	}
+	if err := store.checkNotBlocked(playerId, gamers); err != nil {
+		return err
+	}
+	p := &models.Player{}
+	if err := store.GetPath(ctx, "players/"+playerId, p); err != nil {
+		return err
+	}
+	if p.Bin == "" {
+		return fmt.Errorf("player not found: %s", playerId)
+	}
+
+	return store.SetPath(ctx, "games/"+gameId+"/spectators/"+playerId, &Spectator{
+		PlayerId: playerId,
+		UserName: p.UserName,
+		JoinedAt: FormatTime(time.Now()),
+	})
+}
+
+// RemoveSpectator stops a player watching a game
+func (store *Store) RemoveSpectator(gameId string, playerId string) error {
+	return store.DeletePath(context.Background(), "games/"+gameId+"/spectators/"+playerId)
+}
+
+// GetSpectators returns the spectators of a game by player id
+func (store *Store) GetSpectators(gameId string) (map[string]*Spectator, error) {
+
+	var m map[string]*Spectator
+	if err := store.GetPath(context.Background(), "games/"+gameId+"/spectators", &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// inSpectatorChannel reports whether a player belongs to the spectator channel: spectators and
+// gamers who were eliminated, never living gamers
+func (store *Store) inSpectatorChannel(gameId string, playerId string) (bool, error) {
+
+	ctx := context.Background()
+	var gamer map[string]interface{}
+	if err := store.GetPath(ctx, "games/"+gameId+"/gamers/"+playerId, &gamer); err != nil {
+		return false, err
+	}
+	if gamer != nil {
+		alive, _ := gamer["is_alive"].(bool)
+		return !alive, nil
+	}
+	s := &Spectator{}
+	if err := store.GetPath(ctx, "games/"+gameId+"/spectators/"+playerId, s); err != nil {
+		return false, err
+	}
+	return s.PlayerId != "", nil
+}
+
+// canReadSpectatorChat reports whether a player may read the spectator channel, members always
+// can and every gamer can once the host bridged the channel after the game ended
+func (store *Store) canReadSpectatorChat(gameId string, playerId string) (bool, error) {
+
+	in, err := store.inSpectatorChannel(gameId, playerId)
+	if err != nil || in {
+		return in, err
+	}
+	var bridged bool
+	if err := store.GetPath(context.Background(), "games/"+gameId+"/spectator_chat_bridged", &bridged); err != nil {
+		return false, err
+	}
+	if !bridged {
+		return false, nil
+	}
+	gamers, err := store.GetShallowKeys("games/" + gameId + "/gamers")
+	if err != nil {
+		return false, err
+	}
+	return contains(gamers, playerId), nil
+}
+
+// AddSpectatorMessage posts to the spectator channel, only spectators and eliminated gamers can post
+func (store *Store) AddSpectatorMessage(gameId string, msg *models.Message) error {
+
+	if msg == nil || msg.Timestamp == "" || msg.Source == "" {
+		return fmt.Errorf("invalid message object")
+	}
+	in, err := store.inSpectatorChannel(gameId, msg.Source)
+	if err != nil {
+		return err
+	}
+	if !in {
+		return fmt.Errorf("player %s is not in the spectator channel of game %s", msg.Source, gameId)
+	}
+	return store.SetPath(context.Background(), "games/"+gameId+"/spectator_chat/"+msg.Timestamp+"_"+msg.Source, msg)
+}
+
+// GetSpectatorMessages returns the spectator channel as the reader may see it
+func (store *Store) GetSpectatorMessages(gameId string, readerId string) (map[string]*models.Message, error) {
+
+	ok, err := store.canReadSpectatorChat(gameId, readerId)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("player %s can't read the spectator channel of game %s", readerId, gameId)
+	}
+	var m map[string]*models.Message
+	if err := store.GetPath(context.Background(), "games/"+gameId+"/spectator_chat", &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// BridgeSpectatorChat opens the spectator channel to every gamer of an ended game, only the
+// host can bridge it
+func (store *Store) BridgeSpectatorChat(gameId string, hostId string) error {
+
+	ctx := context.Background()
+	var host string
+	if err := store.GetPath(ctx, "games/"+gameId+"/creator/bin", &host); err != nil {
+		return err
+	}
+	if host == "" || host != hostId {
+		return fmt.Errorf("player %s is not the host of game %s", hostId, gameId)
+	}
+	var status string
+	if err := store.GetPath(ctx, "games/"+gameId+"/status", &status); err != nil {
+		return err
+	}
+	if status != "ended" {
+		return fmt.Errorf("game %s has not ended", gameId)
+	}
+	return store.SetPath(ctx, "games/"+gameId+"/spectator_chat_bridged", true)
+}
@@ -0,0 +1,232 @@
+package v1
+
+import (
+	"context"
+	models "github.com/horcu/pm-models/types"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// TargetTally is the count of final votes on one target
+type TargetTally struct {
+	Target string   `json:"target"`
+	Votes  int      `json:"votes"`
+	Voters []string `json:"voters"`
+}
+
+// VoteBreakdown aggregates the results of a step, counting each gamer's last vote
+type VoteBreakdown struct {
+	GameId    string                  `json:"game_id"`
+	StepId    string                  `json:"step_id"`
+	Cycle     int                     `json:"cycle,omitempty"` // the cycle tallied, zero for the current one or archived results
+	Targets   map[string]*TargetTally `json:"targets"`
+	Leader    string                  `json:"leader,omitempty"` // most voted target, empty on a tie
+	Tied      bool                    `json:"tied"`
+	Votes     int                     `json:"votes"`               // gamers who voted
+	Changes   int                     `json:"changes"`             // votes replaced by a later vote of the same gamer
+	Abilities map[string]int          `json:"abilities,omitempty"` // times each ability was used
+}
+
+// GamerAction is one vote or ability use of a gamer
+type GamerAction struct {
+	StepBin   string `json:"step_bin"`
+	Target    string `json:"target,omitempty"`
+	Ability   string `json:"ability,omitempty"`
+	TimeStamp string `json:"timestamp"`
+}
+
+// sortResults orders results by their unix millisecond timestamps
+func sortResults(results []*models.Result) {
+	sort.SliceStable(results, func(i, j int) bool {
+		a, errA := strconv.ParseInt(results[i].TimeStamp, 10, 64)
+		b, errB := strconv.ParseInt(results[j].TimeStamp, 10, 64)
+		if errA != nil || errB != nil {
+			return results[i].TimeStamp < results[j].TimeStamp
+		}
+		return a < b
+	})
+}
+
+// GetVoteBreakdown tallies the votes cast in a step of a game, in the current cycle or, once a
+// new cycle cleared the step, in the last cycle that recorded it
+func (store *Store) GetVoteBreakdown(gameId string, stepId string) (*VoteBreakdown, error) {
+
+	ctx := context.Background()
+	var byGamer map[string][]*models.Result
+	if err := store.GetPath(ctx, "games/"+gameId+"/steps/"+stepId+"/result", &byGamer); err != nil {
+		return nil, err
+	}
+	cycle := 0
+	if len(byGamer) == 0 {
+		cycles, err := store.getAllCycleResults(ctx, gameId)
+		if err != nil {
+			return nil, err
+		}
+		for key, steps := range cycles {
+			n, _ := strconv.Atoi(strings.TrimPrefix(key, "c"))
+			if len(steps[stepId]) > 0 && n > cycle {
+				cycle, byGamer = n, steps[stepId]
+			}
+		}
+	}
+	if len(byGamer) == 0 {
+		// the step's results may have been moved by ArchiveStepResults
+		var archived map[string][]*models.Result
+		if err := store.GetPath(ctx, "games/"+gameId+"/result", &archived); err != nil {
+			return nil, err
+		}
+		byGamer = make(map[string][]*models.Result)
+		for gamerId, results := range archived {
+			for _, r := range results {
+				if r != nil && r.StepBin == stepId {
+					byGamer[gamerId] = append(byGamer[gamerId], r)
+				}
+			}
+		}
+	}
+	b := tallyVotes(gameId, stepId, byGamer)
+	b.Cycle = cycle
+	return b, nil
+}
+
+// GetCycleVoteBreakdown tallies the votes cast in a step during one cycle of a game
+func (store *Store) GetCycleVoteBreakdown(gameId string, cycle int, stepId string) (*VoteBreakdown, error) {
+
+	steps, err := store.GetCycleResults(gameId, cycle)
+	if err != nil {
+		return nil, err
+	}
+	b := tallyVotes(gameId, stepId, steps[stepId])
+	b.Cycle = cycle
+	return b, nil
+}
+
+// getAllCycleResults reads the results of every cycle, keyed by cycle, step and gamer
+func (store *Store) getAllCycleResults(ctx context.Context, gameId string) (map[string]map[string]map[string][]*models.Result, error) {
+
+	var cycles map[string]map[string]map[string][]*models.Result
+	if err := store.GetPath(ctx, "games/"+gameId+"/cycle_results", &cycles); err != nil {
+		return nil, err
+	}
+	return cycles, nil
+}
+
+// tallyVotes counts each gamer's last vote of a step
+func tallyVotes(gameId string, stepId string, byGamer map[string][]*models.Result) *VoteBreakdown {
+
+	b := &VoteBreakdown{GameId: gameId, StepId: stepId, Targets: make(map[string]*TargetTally)}
+	gamers := make([]string, 0, len(byGamer))
+	for gamerId := range byGamer {
+		gamers = append(gamers, gamerId)
+	}
+	sort.Strings(gamers)
+
+	for _, gamerId := range gamers {
+		var votes []*models.Result
+		for _, r := range byGamer[gamerId] {
+			if r == nil {
+				continue
+			}
+			if r.Vote.Ability != "" {
+				if b.Abilities == nil {
+					b.Abilities = make(map[string]int)
+				}
+				b.Abilities[r.Vote.Ability]++
+			}
+			if r.Vote.Target != "" {
+				votes = append(votes, r)
+			}
+		}
+		if len(votes) == 0 {
+			continue
+		}
+		sortResults(votes)
+		last := votes[len(votes)-1].Vote.Target
+		t := b.Targets[last]
+		if t == nil {
+			t = &TargetTally{Target: last}
+			b.Targets[last] = t
+		}
+		t.Votes++
+		t.Voters = append(t.Voters, gamerId)
+		b.Votes++
+		b.Changes += len(votes) - 1
+	}
+
+	top := 0
+	for target, t := range b.Targets {
+		switch {
+		case t.Votes > top:
+			top, b.Leader, b.Tied = t.Votes, target, false
+		case t.Votes == top:
+			b.Tied = true
+		}
+	}
+	if b.Tied {
+		b.Leader = ""
+	}
+	return b
+}
+
+// GetActionsByGamer returns every vote and ability use of a gamer across the steps and cycles of a game, oldest first
+func (store *Store) GetActionsByGamer(gameId string, gamerId string) ([]*GamerAction, error) {
+
+	ctx := context.Background()
+	steps, err := store.GetShallowKeys("games/" + gameId + "/steps")
+	if err != nil {
+		return nil, err
+	}
+
+	// results moved by ArchiveStepResults come first, skipped when a step still holds a copy
+	var archived, results []*models.Result
+	if err := store.GetPath(ctx, "games/"+gameId+"/result/"+gamerId, &archived); err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	for _, r := range archived {
+		if r != nil {
+			seen[r.Bin] = r.Bin != ""
+			results = append(results, r)
+		}
+	}
+	// earlier cycles were cleared from the steps but kept under cycle_results
+	cycles, err := store.getAllCycleResults(ctx, gameId)
+	if err != nil {
+		return nil, err
+	}
+	for _, byStep := range cycles {
+		for stepId, byGamer := range byStep {
+			for _, r := range byGamer[gamerId] {
+				if r != nil && (r.Bin == "" || !seen[r.Bin]) {
+					seen[r.Bin] = r.Bin != ""
+					if r.StepBin == "" {
+						r.StepBin = stepId
+					}
+					results = append(results, r)
+				}
+			}
+		}
+	}
+	for _, stepId := range steps {
+		var rs []*models.Result
+		if err := store.GetPath(ctx, "games/"+gameId+"/steps/"+stepId+"/result/"+gamerId, &rs); err != nil {
+			return nil, err
+		}
+		for _, r := range rs {
+			if r != nil && (r.Bin == "" || !seen[r.Bin]) {
+				if r.StepBin == "" {
+					r.StepBin = stepId
+				}
+				results = append(results, r)
+			}
+		}
+	}
+	sortResults(results)
+
+	actions := make([]*GamerAction, 0, len(results))
+	for _, r := range results {
+		actions = append(actions, &GamerAction{StepBin: r.StepBin, Target: r.Vote.Target, Ability: r.Vote.Ability, TimeStamp: r.TimeStamp})
+	}
+	return actions, nil
+}
@@ -11,15 +11,18 @@ import (
 	"google.golang.org/api/option"
 	"log"
 	"math/rand"
+	"sort"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
 // Publisher Firebase
 type Publisher struct {
 	*db.Client
-	mu sync.Mutex
+	app *firebase.App
+	mu  sync.Mutex
 }
 
 var (
@@ -33,9 +36,13 @@ var pub Publisher
 
 func (db *Publisher) Connect(firebaseURL string, firebaseAPIKey string, projectID string) error {
 	opt = option.WithCredentialsJSON([]byte(firebaseAPIKey))
+	return db.connect(firebaseURL, projectID, opt)
+}
+
+func (db *Publisher) connect(firebaseURL string, projectID string, opts ...option.ClientOption) error {
 	ctx := context.Background()
 	config := &firebase.Config{DatabaseURL: firebaseURL, ProjectID: projectID}
-	app, err := firebase.NewApp(ctx, config, opt)
+	app, err := firebase.NewApp(ctx, config, opts...)
 	if err != nil {
 		return fmt.Errorf("error initializing app: %v", err)
 	}
@@ -44,6 +51,7 @@ func (db *Publisher) Connect(firebaseURL string, firebaseAPIKey string, projectI
 		return fmt.Errorf("error initializing fb database: %v", err)
 	}
 	db.Client = client
+	db.app = app
 	return nil
 }
 
@@ -53,6 +61,34 @@ func FirebaseDB() *Publisher {
 
 type Store struct {
 	*Publisher
+	events          EventPublisher
+	changeFeed      bool
+	changeActor     string
+	rootPrefix      string
+	shards          *shardRouter
+	bulkWorkers     int
+	coalesce        *coalescer
+	journal         *journal
+	watchInterval   time.Duration
+	inviteSecret    []byte
+	readTimeout     time.Duration
+	writeTimeout    time.Duration
+	validators      map[string][]ValidationRule
+	avatarBucket    string
+	slowThreshold   time.Duration
+	slowReport      func(*SlowOp)
+	limiter         *limiter
+	dryRun          bool
+	secondary       *secondary
+	regions         map[string]string
+	cold            *coldStorage
+	compressResults bool
+	actionQueue     ActionQueue
+	analytics       *analytics
+	flags           atomic.Pointer[flagCache]
+	remoteConfig    remoteConfig
+	maintenance     maintenanceState
+	versions        versionState
 }
 
 func (store *Store) Connect(firebaseURL string, firebaseAPIKey string, projectID string) error {
@@ -60,82 +96,270 @@ func (store *Store) Connect(firebaseURL string, firebaseAPIKey string, projectID
 }
 
 // NewStore returns a Store.
-func NewStore() *Store {
+func NewStore(opts ...StoreOption) *Store {
 	d := FirebaseDB()
-	return &Store{
-		Publisher: d,
+	store := &Store{
+		Publisher:    d,
+		readTimeout:  defaultReadTimeout,
+		writeTimeout: defaultWriteTimeout,
+		analytics:    newAnalytics(AnalyticsOptions{}, nil),
+	}
+	for _, opt := range opts {
+		opt(store)
 	}
+	return store
 }
 
+// Create writes a new record of a data type, ErrInvalidType when b isn't the type's model
 func (store *Store) Create(b interface{}, path string) error {
 	switch path {
 	case "players":
-		return store.CreatePlayer(b.(*models.Player))
+		if p, ok := b.(*models.Player); ok && p != nil {
+			return store.CreatePlayer(p)
+		}
 	case "games":
-		return store.CreateGame(b.(*models.Game))
+		if g, ok := b.(*models.Game); ok && g != nil {
+			return store.CreateGame(g)
+		}
+	case "game_groups":
+		if g, ok := b.(*models.Group); ok && g != nil {
+			return store.createGroup(g)
+		}
 	case "steps":
-		return store.CreateStep(b.(*models.Step))
+		if st, ok := b.(*models.Step); ok && st != nil {
+			return store.CreateStep(st)
+		}
 	case "characters":
-		return store.CreateCharacter(b.(*models.GameCharacter))
+		if c, ok := b.(*models.GameCharacter); ok && c != nil {
+			return store.CreateCharacter(c)
+		}
 	case "abilities":
-		return store.CreateAbility(b.(*models.Ability))
+		if a, ok := b.(*models.Ability); ok && a != nil {
+			return store.CreateAbility(a)
+		}
 	default:
 		return fmt.Errorf("invalid data type: %s", path)
 	}
+	return invalidType(b, path)
 }
 func (store *Store) CreateStep(b *models.Step) error {
 	store.mu.Lock()
-	if err := store.NewRef("steps/"+b.Bin).Set(context.Background(), &b); err != nil {
+	defer store.mu.Unlock()
+	if b == nil {
+		return invalidType(b, "steps")
+	}
+	if err := store.validate("steps", b.Bin, b); err != nil {
+		return err
+	}
+	v, err := withVersion(b)
+	if err != nil {
+		return err
+	}
+	if err := store.SetPath(context.Background(), "steps/"+b.Bin, v); err != nil {
 		return err
 	}
-	store.mu.Unlock()
 	return nil
 }
+
+// ErrGameExists is returned when a game is created with the bin of an existing game
+var ErrGameExists = errors.New("game already exists")
+
 func (store *Store) CreateGame(b *models.Game) error {
+	return store.createGame(b, nil)
+}
+
+// createGame writes a new game with the extra fields set in the same write, e.g. private games'
+// flag and join code. It never overwrites a game with the same bin.
+func (store *Store) createGame(b *models.Game, extra map[string]interface{}) error {
 	store.mu.Lock()
-	if err := store.NewRef("games/"+b.Bin).Set(context.Background(), b); err != nil {
+	defer store.mu.Unlock()
+	if b == nil {
+		return invalidType(b, "games")
+	}
+	if err := store.validate("games", b.Bin, b); err != nil {
+		return err
+	}
+	v, err := withVersion(b)
+	if err != nil {
+		return err
+	}
+	if b.Creator != nil {
+		v["creator"] = SummarizePlayer(b.Creator)
+	}
+	for k, x := range extra {
+		v[k] = x
+	}
+	if err := store.assignNewGame(b.Bin); err != nil {
 		return err
 	}
-	store.mu.Unlock()
+	err = store.TransactionPath(context.Background(), "games/"+b.Bin, func(t db.TransactionNode) (interface{}, error) {
+		var existing interface{}
+		if err := t.Unmarshal(&existing); err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return nil, ErrGameExists
+		}
+		return v, nil
+	})
+	if errors.Is(err, ErrGameExists) {
+		return fmt.Errorf("%w: %s", ErrGameExists, b.Bin)
+	}
+	if err != nil {
+		return err
+	}
+	if b.Creator != nil {
+		store.addPlayerRef(b.Creator.Bin, "games", b.Bin)
+	}
+	store.createGameSummary(b, extra["private"] == true)
+	store.publish(&DomainEvent{Type: EventGameCreated, GameId: b.Bin, GroupId: b.GroupId})
+	return nil
+}
+func (store *Store) createGroup(b *models.Group) error {
+
+	if b == nil {
+		return invalidType(b, "game_groups")
+	}
+	if err := store.validate("game_groups", b.Bin, b); err != nil {
+		return err
+	}
+	v, err := withVersion(b)
+	if err != nil {
+		return err
+	}
+	// groups keep summaries of their players, see PropagatePlayerSummary
+	if b.Creator != nil {
+		v["creator"] = SummarizePlayer(b.Creator)
+	}
+	members := make(map[string]interface{}, len(b.Members))
+	for bin, p := range b.Members {
+		members[bin] = SummarizePlayer(p)
+	}
+	v["members"] = members
+	if err := store.SetPath(context.Background(), "game_groups/"+b.Bin, v); err != nil {
+		return err
+	}
+	if b.Creator != nil {
+		store.addPlayerRef(b.Creator.Bin, "groups", b.Bin)
+	}
+	for bin := range b.Members {
+		store.addPlayerRef(bin, "groups", b.Bin)
+	}
 	return nil
 }
 func (store *Store) CreatePlayer(b *models.Player) error {
 
-	if err := store.NewRef("players/"+b.Bin).Set(context.Background(), b); err != nil {
+	if b == nil {
+		return invalidType(b, "players")
+	}
+	if err := store.validate("players", b.Bin, b); err != nil {
 		return err
 	}
+	v, err := withVersion(b)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	// the user name is claimed first so a taken name never creates the player
+	var owner string
+	if b.UserName != "" {
+		if err := (&ProfilePatch{UserName: &b.UserName}).Validate(); err != nil {
+			return err
+		}
+		if err := store.GetPath(ctx, "usernames/"+userNameKey(b.UserName), &owner); err != nil {
+			return err
+		}
+		if err := store.claimUserName(b.Bin, b.UserName); err != nil {
+			return err
+		}
+	}
+	if err := store.SetPath(ctx, "players/"+b.Bin, v); err != nil {
+		if b.UserName != "" && owner == "" {
+			_ = store.DeletePath(ctx, "usernames/"+userNameKey(b.UserName))
+		}
+		return err
+	}
+	store.publish(&DomainEvent{Type: EventPlayerCreated, PlayerId: b.Bin})
 	return nil
 }
 
+// Delete removes a record of a data type, ErrInvalidType when b isn't the type's model
 func (store *Store) Delete(b interface{}, dataType string) error {
 
 	switch dataType {
 	case "players":
-		return store.DeletePlayer(b.(*models.Player))
+		return store.DeletePlayer(b)
 	case "game_groups":
-		return store.DeleteGameGroup(b.(*models.Group))
+		return store.DeleteGameGroup(b)
 	case "games":
-		return store.DeleteGame(b.(*models.Game))
+		return store.DeleteGame(b)
 	default:
 		return fmt.Errorf("invalid data type: %s", dataType)
 	}
 }
 func (store *Store) DeleteGame(b interface{}) error {
 
-	return store.NewRef("games/" + b.(*models.Game).Bin).Delete(context.Background())
+	g, ok := b.(*models.Game)
+	if !ok || g == nil {
+		return invalidType(b, "games")
+	}
+	bin := g.Bin
+	store.releaseJoinCode(bin)
+	if err := store.DeletePath(context.Background(), "games/"+bin); err != nil {
+		return err
+	}
+	store.deleteGameSummary(bin)
+	return nil
 }
 func (store *Store) DeleteGameGroup(b interface{}) error {
 
-	return store.NewRef("game_groups/" + b.(*models.Group).Bin).Delete(context.Background())
+	g, ok := b.(*models.Group)
+	if !ok || g == nil {
+		return invalidType(b, "game_groups")
+	}
+	tags, err := store.GetGroupTags(g.Bin)
+	if err != nil {
+		return err
+	}
+	m := map[string]interface{}{"game_groups/" + g.Bin: nil}
+	for _, t := range tags {
+		m["group_tags/"+t+"/"+g.Bin] = nil
+	}
+	if err := store.UpdatePath(context.Background(), "/", m); err != nil {
+		return err
+	}
+	store.deleteGroupListing(g.Bin)
+	return nil
 }
 func (store *Store) DeletePlayer(b interface{}) error {
 
-	if b == nil {
-		return fmt.Errorf("invalid player object")
+	p, ok := b.(*models.Player)
+	if !ok || p == nil {
+		return invalidType(b, "players")
+	}
+	ctx := context.Background()
+	m := map[string]interface{}{
+		"players/" + p.Bin:      nil,
+		"player_notes/" + p.Bin: nil,
+	}
+	// free the player's user name, unless the index already gave it to someone else
+	var name, owner string
+	if err := store.GetPath(ctx, "players/"+p.Bin+"/user_name", &name); err != nil {
+		return err
+	}
+	if name != "" {
+		if err := store.GetPath(ctx, "usernames/"+userNameKey(name), &owner); err != nil {
+			return err
+		}
+		if owner == p.Bin {
+			m["usernames/"+userNameKey(name)] = nil
+		}
 	}
-	return store.NewRef("players/" + b.(*models.Player).Bin).Delete(context.Background())
+	return store.UpdatePath(ctx, "/", m)
 }
-func (store *Store) GetByBin(b string, dataType string) (interface{}, error) {
+
+// newRecord returns an empty model of a data type to decode into
+func newRecord(dataType string) (interface{}, error) {
 
 	var t interface{}
 	if dataType == "players" {
@@ -151,8 +375,21 @@ func (store *Store) GetByBin(b string, dataType string) (interface{}, error) {
 	} else {
 		return nil, fmt.Errorf("invalid data type: %s", dataType)
 	}
+	return t, nil
+}
+
+func (store *Store) GetByBin(b string, dataType string) (interface{}, error) {
 
-	if err := store.NewRef(dataType+"/"+b).Get(context.Background(), t); err != nil {
+	t, err := newRecord(dataType)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw interface{}
+	if err := store.GetPath(context.Background(), dataType+"/"+b, &raw); err != nil {
+		return nil, err
+	}
+	if err := decodeVersioned(dataType, raw, t); err != nil {
 		return nil, err
 	}
 
@@ -161,7 +398,7 @@ func (store *Store) GetByBin(b string, dataType string) (interface{}, error) {
 func (store *Store) GetGamerByBin(b string, gId string) (*models.Gamer, error) {
 
 	var t *models.Gamer
-	if err := store.NewRef("games/"+gId+"/gamers/"+b).Get(context.Background(), &t); err != nil {
+	if err := store.GetPath(context.Background(), "games/"+gId+"/gamers/"+b, &t); err != nil {
 		return nil, err
 	}
 
@@ -185,21 +422,50 @@ func (store *Store) Update(b string, m map[string]interface{}, path string) erro
 }
 
 func (store *Store) UpdateGame(b string, m map[string]interface{}) error {
-	if err := store.NewRef("games/"+b).Update(context.Background(), m); err != nil {
+	if err := store.validateUpdate("games", b, m); err != nil {
 		return err
 	}
+	if err := store.UpdatePath(context.Background(), "games/"+b, bumpRevision(m)); err != nil {
+		return err
+	}
+	if touchesSummary(m) {
+		store.refreshGameSummary(b, nil)
+	}
 	return nil
 }
 
 func (store *Store) UpdateGameGroup(b string, m map[string]interface{}) error {
-	if err := store.NewRef("game_groups/"+b).Update(context.Background(), m); err != nil {
+	if err := store.validateUpdate("game_groups", b, m); err != nil {
 		return err
 	}
+	if err := store.UpdatePath(context.Background(), "game_groups/"+b, bumpRevision(m)); err != nil {
+		return err
+	}
+	if touchesDiscovery(m) {
+		store.refreshGroupListing(b)
+	}
 	return nil
 }
 
+// UpdatePlayer writes raw player fields, profile changes made for players go through UpdatePlayerProfile
 func (store *Store) UpdatePlayer(b string, m map[string]interface{}) error {
-	if err := store.NewRef("players/"+b).Update(context.Background(), m); err != nil {
+	if err := store.validateUpdate("players", b, m); err != nil {
+		return err
+	}
+	// status changes go through the transition rules
+	if status, ok := m["status"].(string); ok {
+		if _, err := store.SetPlayerStatus(b, status, ""); err != nil {
+			return err
+		}
+		rest := make(map[string]interface{}, len(m))
+		for k, v := range m {
+			if k != "status" {
+				rest[k] = v
+			}
+		}
+		m = rest
+	}
+	if err := store.UpdatePath(context.Background(), "players/"+b, bumpRevision(m)); err != nil {
 		return err
 	}
 	return nil
@@ -207,84 +473,111 @@ func (store *Store) UpdatePlayer(b string, m map[string]interface{}) error {
 
 func (store *Store) AddInvitationToPlayer(playerId string, bin string, m *models.Invitation) error {
 
-	err := store.NewRef("players/"+playerId+"/invitations/"+bin).Set(context.Background(), m)
+	// the index lets invite links find the invitation's owner
+	err := store.UpdatePath(context.Background(), "/", map[string]interface{}{
+		"players/" + playerId + "/invitations/" + bin: m,
+		"invitation_index/" + bin:                     playerId,
+	})
 	if err != nil {
 		return err
 	}
+	store.publish(&DomainEvent{Type: EventInvitationCreated, PlayerId: playerId, GameId: m.GameId, GroupId: m.GameGroup,
+		Data: map[string]interface{}{"invitation": bin, "creator_id": m.CreatorId}})
 
 	return nil
 }
 
 func (store *Store) AddPlayerToGroupMembers(gId string, bin string, m *models.Player) error {
 
-	err := store.NewRef("game_groups/"+gId+"/members/"+bin).Set(context.Background(), m)
+	if m == nil {
+		return invalidType(m, "players")
+	}
+	// members hold the player's summary and role, never the full player
+	err := store.UpdateGameGroup(gId, map[string]interface{}{
+		"members/" + bin: memberNode(m, RoleMember),
+	})
 	if err != nil {
 		return err
 	}
+	store.addPlayerRef(bin, "groups", gId)
 
 	return nil
 }
 
 func (store *Store) AddInvitationToGame(gameId string, m map[string]interface{}) error {
 
-	_, err := store.NewRef("games/"+gameId+"/invitations").Push(context.Background(), m)
+	ref, err := store.PushPath(context.Background(), "games/"+gameId+"/invitations", m)
 	if err != nil {
 		return err
 	}
+	store.publish(&DomainEvent{Type: EventInvitationCreated, GameId: gameId, Data: map[string]interface{}{"invitation": ref.Key}})
 
 	return nil
 }
 
-func (store *Store) GetAllPlayers() ([]*models.Player, error) {
+// GetAllPlayers lists the players, ordered, limited and projected by the first of opts when given.
+// Without a projection every player is reduced to its public profile.
+func (store *Store) GetAllPlayers(opts ...ListOptions) ([]*models.Player, error) {
 
-	var m interface{}
-	if err := store.NewRef("players/").Get(context.Background(), &m); err != nil {
+	o := firstListOptions(opts)
+	nodes, err := store.queryList(context.Background(), "players", o)
+	if err != nil {
 		return nil, err
 	}
-	// convert m to a list of players
+	// convert the nodes to a list of players
 	var players []*models.Player
-	for _, v := range m.(map[string]interface{}) {
-		p := v.(map[string]interface{})
-		players = append(players, &models.Player{
-			Bin:      p["bin"].(string),
-			UserName: p["user_name"].(string),
-			Status:   p["status"].(string),
-			Photo:    p["photo"].(string),
-			Privacy:  p["privacy"].(string),
-		})
+	for _, n := range nodes {
+		var v interface{}
+		if err := n.Unmarshal(&v); err != nil {
+			return nil, err
+		}
+		if p := rawMap(v); p != nil {
+			players = append(players, parseRawPlayer(p))
+		}
 	}
-	return players, nil
+	return projectPlayers(players, o.Fields), nil
 }
 
 func (store *Store) getGameByBin(bin string) (*models.Game, error) {
 
-	var g *models.Game
-	if err := store.NewRef("games/"+bin).Get(context.Background(), &g); err != nil {
+	var raw interface{}
+	if err := store.GetPath(context.Background(), "games/"+bin, &raw); err != nil {
 
 		return nil, err
 	}
+	if raw == nil {
+		return nil, nil
+	}
+	g := &models.Game{}
+	if err := decodeVersioned("games", raw, g); err != nil {
+		return nil, err
+	}
 	return g, nil
 }
 
 func (store *Store) getAllGroups() ([]*models.Group, error) {
 
 	var m interface{}
-	if err := store.NewRef("game_groups/").Get(context.Background(), &m); err != nil {
+	if err := store.GetPath(context.Background(), "game_groups/", &m); err != nil {
 		return nil, err
 	}
 
 	// convert m to a list of Groups
 	var groups []*models.Group
-	for _, v := range m.(map[string]interface{}) {
-		g := v.(map[string]interface{})
-		groups = append(groups, &models.Group{
-			Bin:       g["bin"].(string),
-			Creator:   g["creator"].(*models.Player),
-			Members:   g["members"].(map[string]*models.Player),
-			GroupName: g["group_name"].(string),
-			Capacity:  int(g["capacity"].(float64)),
-			Status:    g["status"].(string),
-		})
+	for _, v := range rawItems(m) {
+		g := rawMap(v)
+		if g == nil {
+			continue
+		}
+		group := &models.Group{
+			Bin:       rawString(g, "bin"),
+			GroupName: rawString(g, "group_name"),
+			Capacity:  rawInt(g, "capacity"),
+			Status:    rawString(g, "status"),
+		}
+		rawDecode(g, "creator", &group.Creator)
+		rawDecode(g, "members", &group.Members)
+		groups = append(groups, group)
 	}
 	return groups, nil
 }
@@ -292,27 +585,16 @@ func (store *Store) getAllGroups() ([]*models.Group, error) {
 func (store *Store) getAllSteps() ([]*models.Step, error) {
 
 	var m interface{}
-	if err := store.NewRef("steps").Get(context.Background(), &m); err != nil {
+	if err := store.GetPath(context.Background(), "steps", &m); err != nil {
 		return nil, err
 	}
 
 	// convert m to a list of steps
 	var steps []*models.Step
-	for _, v := range m.([]interface{}) {
-		var st = v.(map[string]interface{})
-		steps = append(steps, &models.Step{
-			Bin:          st["bin"].(string),
-			StepType:     st["step_type"].(string),
-			Duration:     st["duration"].(string),
-			Command:      st["command"].(string),
-			Characters:   st["characters"].(map[string]*models.GameCharacter),
-			StepIndex:    int(st["step_index"].(float64)),
-			SubSteps:     st["sub_steps"].(map[string]*models.Step),
-			RequiresVote: st["requires_vote"].(bool),
-			VoteType:     st["vote_type"].(string),
-			Allowed:      st["allowed"].([]string),
-			NextStep:     st["next_step"].(string),
-		})
+	for _, v := range rawItems(m) {
+		if st := rawMap(v); st != nil {
+			steps = append(steps, parseRawStep(st))
+		}
 	}
 	return steps, nil
 }
@@ -320,7 +602,7 @@ func (store *Store) getAllSteps() ([]*models.Step, error) {
 func (store *Store) getGameGroup(bin string) (*models.Group, error) {
 
 	var g *models.Group
-	if err := store.NewRef("game_groups/"+bin).Get(context.Background(), g); err != nil {
+	if err := store.GetPath(context.Background(), "game_groups/"+bin, &g); err != nil {
 		return nil, err
 	}
 	return g, nil
@@ -329,7 +611,7 @@ func (store *Store) getGameGroup(bin string) (*models.Group, error) {
 func (store *Store) getPlayer(bin string) (*models.Player, error) {
 
 	var p *models.Player
-	if err := store.NewRef("players/"+bin).Get(context.Background(), p); err != nil {
+	if err := store.GetPath(context.Background(), "players/"+bin, &p); err != nil {
 		return nil, err
 	}
 	return p, nil
@@ -338,12 +620,11 @@ func (store *Store) getPlayer(bin string) (*models.Player, error) {
 func (store *Store) getAllGames() ([]*models.Game, error) {
 
 	var m interface{}
-	if err := store.NewRef("games/").Get(context.Background(), &m); err != nil {
+	if err := store.GetPath(context.Background(), "games/", &m); err != nil {
 		return nil, err
 	}
 
-	// Dereference the pointer to the interface
-	gamesMap := m.(map[string]interface{})
+	gamesMap := rawMap(m)
 
 	if len(gamesMap) == 0 {
 		var l = make([]*models.Game, 0)
@@ -352,7 +633,10 @@ func (store *Store) getAllGames() ([]*models.Game, error) {
 	// convert m to a list of games
 	var games []*models.Game
 	for _, v := range gamesMap {
-		g := v.(map[string]interface{})
+		g := rawMap(v)
+		if g == nil {
+			continue
+		}
 
 		// Convert the "invited" slice
 		invited := store.ParsePlayerList(g, "invited")
@@ -372,211 +656,169 @@ func (store *Store) getAllGames() ([]*models.Game, error) {
 		}
 
 		// add parsed Game to list
-		games = append(games, &models.Game{
-			Bin: g["bin"].(string),
+		game := &models.Game{
+			Bin: rawString(g, "bin"),
 			GameEvents: models.Events{
-				IsDaytime:         g["is_daytime"].(bool),
-				FirstDayCompleted: g["first_day_completed"].(bool),
+				IsDaytime:         rawBool(g, "is_daytime"),
+				FirstDayCompleted: rawBool(g, "first_day_completed"),
 			},
 			CurrentStep: step.Bin,
-			Info:        g["info"].(*models.ServerInfo),
-			Status:      g["status"].(string),
+			Status:      rawString(g, "status"),
 			Sync: &models.TimeSync{
-				StartTime: g["start_time"].(string),
-				EndTime:   g["end_time"].(string),
+				StartTime: rawString(g, "start_time"),
+				EndTime:   rawString(g, "end_time"),
 			},
 			Creator: creator,
-		})
+		}
+		rawDecode(g, "info", &game.Info)
+		rawDecode(g, "sync", game.Sync)
+		games = append(games, game)
 	}
 	return games, nil
 }
 
 func (store *Store) ParsePlayerList(pMap map[string]interface{}, path string) map[string]*models.Player {
 
-	var players map[string]*models.Player
-
-	if pMap[path] == nil {
-		return make(map[string]*models.Player, 0)
-	}
-
-	interF := pMap[path].([]interface{})
-	if len(interF) == 0 {
-		return make(map[string]*models.Player, 0)
-	}
-
-	for _, playerInterface := range interF {
-
-		playerMap := playerInterface.(map[string]interface{})
-		var p = &models.Player{
-			Bin:      playerMap["bin"].(string),
-			UserName: playerMap["user_name"].(string),
-			Status:   playerMap["status"].(string),
-			Photo:    playerMap["photo"].(string),
-			Privacy:  playerMap["privacy"].(string),
+	players := make(map[string]*models.Player)
+	for _, playerInterface := range rawItems(pMap[path]) {
+		if playerMap := rawMap(playerInterface); playerMap != nil {
+			p := parseRawPlayer(playerMap)
+			players[p.Bin] = p
 		}
-		players[p.Bin] = p
 	}
 	return players
 }
 
 func (store *Store) ParseCurrentStep(pMap interface{}, path string) *models.Step {
 
-	var step *models.Step
-
-	playerMap := pMap.(map[string]interface{})
-	st := playerMap[path].(map[string]interface{})
-	step = &models.Step{
-		Bin:          st["bin"].(string),
-		StepType:     st["step_type"].(string),
-		Duration:     st["duration"].(string),
-		Command:      st["command"].(string),
-		Characters:   st["characters"].(map[string]*models.GameCharacter),
-		StepIndex:    int(st["step_index"].(float64)),
-		SubSteps:     st["sub_steps"].(map[string]*models.Step),
-		RequiresVote: st["requires_vote"].(bool),
-		VoteType:     st["vote_type"].(string),
-		Allowed:      st["allowed"].([]string),
-		NextStep:     st["next_step"].(string),
+	// games store the current step as its bin, older records embedded the step
+	v := rawMap(pMap)[path]
+	if bin, ok := v.(string); ok {
+		return &models.Step{Bin: bin}
 	}
-	return step
+	if st := rawMap(v); st != nil {
+		return parseRawStep(st)
+	}
+	return &models.Step{}
 }
 
 func (store *Store) ParseGroup(pMap interface{}, path string) *models.Group {
 
-	var step *models.Group
-
-	playerMap := pMap.(map[string]interface{})
-	interF := playerMap[path].(map[string]interface{})
-	step = &models.Group{
-		Bin:       interF["bin"].(string),
+	interF := rawMap(rawMap(pMap)[path])
+	if interF == nil {
+		return &models.Group{}
+	}
+	return &models.Group{
+		Bin:       rawString(interF, "bin"),
 		Creator:   store.ParsePlayer(interF, "creator"),
 		Members:   store.ParsePlayerList(interF, "members"),
-		GroupName: interF["group_name"].(string),
-		Capacity:  int(interF["capacity"].(float64)),
-		Status:    interF["status"].(string),
+		GroupName: rawString(interF, "group_name"),
+		Capacity:  rawInt(interF, "capacity"),
+		Status:    rawString(interF, "status"),
 	}
-	return step
 }
 
 func (store *Store) ParsePlayer(g interface{}, path string) *models.Player {
 
-	var group *models.Player
-
-	playerMap := g.(map[string]interface{})
-	if playerMap[path] == nil {
+	interF := rawMap(rawMap(g)[path])
+	if interF == nil {
 		return &models.Player{}
 	}
-	interF := playerMap[path].(map[string]interface{})
-	group = &models.Player{
-		Bin:      interF["bin"].(string),
-		UserName: interF["user_name"].(string),
-		Status:   interF["status"].(string),
-		Photo:    interF["photo"].(string),
-		Privacy:  interF["privacy"].(string),
-	}
-	return group
+	return parseRawPlayer(interF)
 }
 
 func (store *Store) ParseInvitationList(pMap map[string]interface{}, path string) ([]*models.Invitation, error) {
 
-	var accepted []*models.Invitation
-	acceptedInterface := pMap[path].([]interface{})
-
-	if len(acceptedInterface) == 0 {
-		var l = make([]*models.Invitation, 0)
-		return l, nil
-	}
-
-	for _, playerInterface := range acceptedInterface {
-		playerMap := playerInterface.(map[string]interface{})
+	accepted := make([]*models.Invitation, 0)
+	for _, playerInterface := range rawItems(pMap[path]) {
+		playerMap := rawMap(playerInterface)
+		if playerMap == nil {
+			return nil, invalidType(playerInterface, path)
+		}
 		accepted = append(accepted, &models.Invitation{
-			Bin:        playerMap["bin"].(string),
-			GameGroup:  playerMap["game_group"].(string),
-			CreatorId:  playerMap["creator_id"].(string),
-			Status:     playerMap["status"].(string),
-			Invitation: playerMap["invitation"].(string),
-			Message:    playerMap["message"].(string),
-			Time:       playerMap["time"].(string),
-			GameId:     playerMap["game_id"].(string),
-			Accepted:   playerMap["accepted"].(bool),
-			Declined:   playerMap["declined"].(bool),
+			Bin:        rawString(playerMap, "bin"),
+			GameGroup:  rawString(playerMap, "game_group"),
+			CreatorId:  rawString(playerMap, "creator_id"),
+			Status:     rawString(playerMap, "status"),
+			Invitation: rawString(playerMap, "invitation"),
+			Message:    rawString(playerMap, "message"),
+			Time:       rawString(playerMap, "time"),
+			GameId:     rawString(playerMap, "game_id"),
+			Accepted:   rawBool(playerMap, "accepted"),
+			Declined:   rawBool(playerMap, "declined"),
 		})
 	}
 	return accepted, nil
 }
 
-func (store *Store) GetGameGroupMembers(groupId string) ([]*models.Player, error) {
+// GetGameGroupMembers lists the members of a group, ordered, limited and projected by the first of
+// opts when given. Without a projection every member is reduced to its public profile.
+func (store *Store) GetGameGroupMembers(groupId string, opts ...ListOptions) ([]*models.Player, error) {
 
-	var m interface{}
-	if err := store.NewRef("game_groups/"+groupId+"/members").Get(context.Background(), &m); err != nil {
+	o := firstListOptions(opts)
+	nodes, err := store.queryList(context.Background(), "game_groups/"+groupId+"/members", o)
+	if err != nil {
 		return nil, err
 	}
 
-	// convert m to a list of players
+	// convert the nodes to a list of players
 	var players []*models.Player
-	for _, v := range m.(map[string]interface{}) {
-		p := v.(map[string]interface{})
-		players = append(players, &models.Player{
-			Bin:      p["bin"].(string),
-			UserName: p["user_name"].(string),
-			Status:   p["status"].(string),
-			Photo:    p["photo"].(string),
-			Privacy:  p["privacy"].(string),
-		})
+	for _, n := range nodes {
+		var p map[string]interface{}
+		if err := n.Unmarshal(&p); err != nil {
+			return nil, err
+		}
+		players = append(players, parseRawPlayer(p))
 	}
-	return players, nil
+	return projectPlayers(players, o.Fields), nil
 }
 
 func (store *Store) GetGameGroupInvitations(groupId string) ([]*models.Invitation, error) {
 
 	var m interface{}
-	if err := store.NewRef("game_groups/"+groupId+"/invitations").Get(context.Background(), &m); err != nil {
+	if err := store.GetPath(context.Background(), "game_groups/"+groupId+"/invitations", &m); err != nil {
 		return nil, err
 	}
 
 	// convert m to a list of invitations
 	var invitations []*models.Invitation
-	for _, v := range m.(map[string]interface{}) {
-		inv := v.(map[string]interface{})
+	for _, v := range rawItems(m) {
+		inv := rawMap(v)
+		if inv == nil {
+			continue
+		}
 		invitations = append(invitations, &models.Invitation{
-			Bin:       inv["bin"].(string),
-			GameGroup: inv["game_group"].(string),
-			CreatorId: inv["creator"].(string),
+			Bin:       rawString(inv, "bin"),
+			GameGroup: rawString(inv, "game_group"),
+			CreatorId: rawString(inv, "creator"),
 		})
 	}
 	return invitations, nil
 }
 
-func (store *Store) GetStepsByGameId(gameId string) ([]*models.Step, error) {
+// GetStepsByGameId lists the steps of a game, ordered and limited by the first of opts when given,
+// e.g. ListOptions{OrderBy: "step_index"}
+func (store *Store) GetStepsByGameId(gameId string, opts ...ListOptions) ([]*models.Step, error) {
 
-	var m interface{}
-	if err := store.NewRef("games/"+gameId+"/steps").Get(context.Background(), &m); err != nil {
+	nodes, err := store.queryList(context.Background(), "games/"+gameId+"/steps", firstListOptions(opts))
+	if err != nil {
 		return nil, err
 	}
 
-	// convert m to a list of steps
+	// convert the nodes to a list of steps
 	var steps []*models.Step
-	for _, v := range m.(map[string]interface{}) {
-		st := v.(map[string]interface{})
-		steps = append(steps, &models.Step{
-			Bin:          st["bin"].(string),
-			StepType:     st["step_type"].(string),
-			Duration:     st["duration"].(string),
-			Command:      st["command"].(string),
-			Characters:   st["characters"].(map[string]*models.GameCharacter),
-			StepIndex:    int(st["step_index"].(float64)),
-			SubSteps:     st["sub_steps"].(map[string]*models.Step),
-			RequiresVote: st["requires_vote"].(bool),
-			VoteType:     st["vote_type"].(string),
-			Allowed:      st["allowed"].([]string),
-			NextStep:     st["next_step"].(string),
-		})
+	for _, n := range nodes {
+		var st map[string]interface{}
+		if err := n.Unmarshal(&st); err != nil {
+			return nil, err
+		}
+		steps = append(steps, parseRawStep(st))
 	}
 	return steps, nil
 }
 
 func (store *Store) UpdateInvitation(pId string, inviteId string, m map[string]interface{}) interface{} {
-	err := store.NewRef("players/"+pId+"/invitations/"+inviteId).Update(context.Background(), m)
+	err := store.UpdatePath(context.Background(), "players/"+pId+"/invitations/"+inviteId, m)
 	if err != nil {
 		return err
 	}
@@ -587,7 +829,17 @@ func (store *Store) UpdateInvitation(pId string, inviteId string, m map[string]i
 
 func (store *Store) CreateCharacter(character *models.GameCharacter) error {
 
-	if err := store.NewRef("characters/"+character.Bin).Set(context.Background(), character); err != nil {
+	if character == nil {
+		return invalidType(character, "characters")
+	}
+	if err := store.validate("characters", character.Bin, character); err != nil {
+		return err
+	}
+	v, err := withVersion(character)
+	if err != nil {
+		return err
+	}
+	if err := store.SetPath(context.Background(), "characters/"+character.Bin, v); err != nil {
 		return err
 	}
 	return nil
@@ -595,39 +847,45 @@ func (store *Store) CreateCharacter(character *models.GameCharacter) error {
 
 func (store *Store) AddStepToGame(step *models.Step, id string) error {
 
-	if err := store.NewRef("games/"+id+"/steps/"+step.Bin).Set(context.Background(), step); err != nil {
+	if err := store.SetPath(context.Background(), "games/"+id+"/steps/"+step.Bin, step); err != nil {
 		return err
 	}
 	return nil
 }
 
 func (store *Store) UpdateGamersInGame(b string, m map[string]interface{}) error {
-	err := store.NewRef("games/"+b+"gamers").Update(context.Background(), m)
+	err := store.UpdatePath(context.Background(), "games/"+b+"/gamers", m)
 	if err != nil {
 		return err
 	}
+	store.refreshGameSummary(b, nil)
+	// gamers who left or were kicked free their slots
+	if removesGamers(m, "") {
+		store.promoteGameWaitlist(b)
+	}
 
 	return nil
 
 }
 
+// SetGameStartAndEndTimes parses the times, see ParseTime, and stores them with SetGameTimes
 func (store *Store) SetGameStartAndEndTimes(gameId string, startTime string, endTime string) error {
 
-	m := map[string]interface{}{
-		"start_time": startTime,
-		"end_time":   endTime,
-	}
-
-	err := store.NewRef("games/"+gameId).Update(context.Background(), m)
+	start, err := ParseTime(startTime)
 	if err != nil {
 		return err
 	}
-
-	return nil
+	var end time.Time
+	if endTime != "" {
+		if end, err = ParseTime(endTime); err != nil {
+			return err
+		}
+	}
+	return store.SetGameTimes(gameId, start, end)
 }
 
 func (store *Store) AddToGame(path string, bin string, c *models.GameCharacter) error {
-	if err := store.NewRef("games/"+bin+"/"+path+"/"+c.Bin).Set(context.Background(), &c); err != nil {
+	if err := store.SetPath(context.Background(), "games/"+bin+"/"+path+"/"+c.Bin, &c); err != nil {
 		return err
 	}
 	return nil
@@ -635,7 +893,17 @@ func (store *Store) AddToGame(path string, bin string, c *models.GameCharacter)
 
 func (store *Store) CreateAbility(ability *models.Ability) error {
 
-	if err := store.NewRef("abilities/"+ability.Bin).Set(context.Background(), ability); err != nil {
+	if ability == nil {
+		return invalidType(ability, "abilities")
+	}
+	if err := store.validate("abilities", ability.Bin, ability); err != nil {
+		return err
+	}
+	v, err := withVersion(ability)
+	if err != nil {
+		return err
+	}
+	if err := store.SetPath(context.Background(), "abilities/"+ability.Bin, v); err != nil {
 		return err
 	}
 	return nil
@@ -643,7 +911,7 @@ func (store *Store) CreateAbility(ability *models.Ability) error {
 
 func (store *Store) SetGameFirstStep(bin string, step string) error {
 
-	if err := store.NewRef("games/"+bin+"/current_step/").Set(context.Background(), step); err != nil {
+	if err := store.SetPath(context.Background(), "games/"+bin+"/current_step/", step); err != nil {
 		return err
 	}
 	return nil
@@ -651,10 +919,10 @@ func (store *Store) SetGameFirstStep(bin string, step string) error {
 
 func (store *Store) ResetFirstDayAndExplanationFlag(bin string) error {
 
-	if err := store.NewRef("games/"+bin+"/first_day_completed/").Set(context.Background(), false); err != nil {
+	if err := store.SetPath(context.Background(), "games/"+bin+"/first_day_completed/", false); err != nil {
 		return err
 	}
-	if err := store.NewRef("games/"+bin+"/explanation_seen/").Set(context.Background(), false); err != nil {
+	if err := store.SetPath(context.Background(), "games/"+bin+"/explanation_seen/", false); err != nil {
 		return err
 	}
 	return nil
@@ -663,7 +931,7 @@ func (store *Store) ResetFirstDayAndExplanationFlag(bin string) error {
 func (store *Store) GetStepByBin(step string) (*models.Step, error) {
 
 	c := &models.Step{}
-	if err := store.NewRef("steps/"+step).Get(context.Background(), c); err != nil {
+	if err := store.GetPath(context.Background(), "steps/"+step, c); err != nil {
 		return nil, err
 	}
 	if c.Bin == "" {
@@ -675,7 +943,7 @@ func (store *Store) GetStepByBin(step string) (*models.Step, error) {
 func (store *Store) GetCharacterByBin(id string) (*models.GameCharacter, error) {
 
 	c := &models.GameCharacter{}
-	if err := store.NewRef("characters/"+id).Get(context.Background(), c); err != nil {
+	if err := store.GetPath(context.Background(), "characters/"+id, c); err != nil {
 		return nil, err
 	}
 	if c.Bin == "" {
@@ -687,7 +955,7 @@ func (store *Store) GetCharacterByBin(id string) (*models.GameCharacter, error)
 
 func (store *Store) IncrementGameCounter(game *models.Game, val int) error {
 	// increment the game.counter value in firebase
-	if err := store.NewRef("game/"+game.Bin+"/counter").Set(context.Background(), &val); err != nil {
+	if err := store.SetPath(context.Background(), "game/"+game.Bin+"/counter", &val); err != nil {
 		return err
 	}
 	return nil
@@ -695,36 +963,30 @@ func (store *Store) IncrementGameCounter(game *models.Game, val int) error {
 
 func (store *Store) UpdateVoteStep(gameBin string, stepBin string, updateStep map[string]interface{}) error {
 
-	return store.NewRef("games/"+gameBin+"/steps/"+stepBin).Update(context.Background(), updateStep)
+	return store.UpdatePath(context.Background(), "games/"+gameBin+"/steps/"+stepBin, updateStep)
 }
 
+// UpdateGamer updates the gamers of a game, merged with other updates in the window when the store coalesces
 func (store *Store) UpdateGamer(gameId string, gx map[string]interface{}) bool {
-	if err := store.NewRef("games/"+gameId+"/gamers/").Update(context.Background(), gx); err != nil {
+	if err := store.coalesceUpdate("games/"+gameId+"/gamers", gx); err != nil {
 		return false
 	}
 	return true
 }
 
 func (store *Store) UpdateGamerAbilities(gameId string, gamerId string, abBin string, ab *models.Ability) bool {
-	if err := store.NewRef("games/"+gameId+"/gamers/"+gamerId+"/abilities/"+abBin).Set(context.Background(), &ab); err != nil {
+	if err := store.SetPath(context.Background(), "games/"+gameId+"/gamers/"+gamerId+"/abilities/"+abBin, &ab); err != nil {
 		return false
 	}
 	return true
 }
 
 func (store *Store) AddAbilitiesToDb(abilities map[string]*models.Ability) error {
-
-	for _, a := range abilities {
-		err := store.Create(a, "abilities")
-		if err != nil {
-			return err
-		}
-	}
-	return nil
+	return store.AddAbilitiesToDbContext(context.Background(), abilities)
 }
 
 func (store *Store) AddAbilitiesToGame(gameId string, abilities map[string]*models.Ability) error {
-	if err := store.NewRef("games/"+gameId+"/abilities").Set(context.Background(), &abilities); err != nil {
+	if err := store.SetPath(context.Background(), "games/"+gameId+"/abilities", &abilities); err != nil {
 		return err
 	}
 	return nil
@@ -739,19 +1001,41 @@ func (store *Store) GetAbilitiesForCharacter(characterId string) ([]*models.Abil
 	}
 
 	var parsedChar = character.(*models.GameCharacter)
+	var bins []string
 	for _, ability := range parsedChar.Abilities {
-		ab, err := store.GetByBin(ability.Bin, "abilities")
-		if err != nil {
-			break
+		if ability != nil {
+			bins = append(bins, ability.Bin)
+		}
+	}
+	sort.Strings(bins)
+
+	// the abilities that could be read are returned along with the failures of the others
+	found, failed := store.GetByBins("abilities", bins)
+	var errs []error
+	for _, bin := range bins {
+		if ab, ok := found[bin]; ok {
+			abilities = append(abilities, ab.(*models.Ability))
+		} else if err, ok := failed[bin]; ok {
+			errs = append(errs, fmt.Errorf("error getting ability %s: %v", bin, err))
 		}
-		ability := ab.(*models.Ability)
-		abilities = append(abilities, ability)
 	}
 
-	return abilities, nil
+	return abilities, errors.Join(errs...)
 }
 
+// SetNewStep runs setNewStep holding the game's step lock. Only a game without a current step is
+// moved to the first one, so an instance that takes the lock after another started it is a no-op.
 func (store *Store) SetNewStep(gameId string) {
+	err := store.withGameLock(gameId, "step", func() error {
+		store.setNewStep(gameId)
+		return nil
+	})
+	if err != nil {
+		log.Printf("Error advancing game %s: %v", gameId, err)
+	}
+}
+
+func (store *Store) setNewStep(gameId string) {
 
 	// find game
 	game, err := store.GetByBin(gameId, "games")
@@ -763,65 +1047,100 @@ func (store *Store) SetNewStep(gameId string) {
 	g := game.(*models.Game)
 
 	// set the game's current step
+	if err := store.moveStep(gameId, "", "1"); err != nil {
+		log.Printf("Error starting the steps of game %s: %v", gameId, err)
+		return
+	}
 	g.CurrentStep = "1"
 
-	// update the game
-	err = store.Update(gameId, map[string]interface{}{
-		"current_step": g.CurrentStep,
-	}, "games")
-	if err != nil {
-		return
+	// the first step also starts the first cycle
+	if g.NightCycles == 0 {
+		if err := store.Update(gameId, map[string]interface{}{"cycles": 1}, "games"); err != nil {
+			return
+		}
 	}
+	store.logAction(gameId, &GameAction{Type: ActionStepAdvance, StepBin: g.CurrentStep})
+	store.publish(&DomainEvent{Type: EventStepAdvanced, GameId: gameId, Data: map[string]interface{}{"step": g.CurrentStep}})
 
 	return
 }
 
-func (store *Store) SetNextStep(gameId string) {
+// SetNextStep runs setNextStep holding the game's step lock. The game only advances while its
+// current step is still fromStep, so concurrent instances can't advance it twice.
+func (store *Store) SetNextStep(gameId string, fromStep string) {
+	if err := store.advanceStep(gameId, fromStep); err != nil {
+		log.Printf("Error advancing game %s: %v", gameId, err)
+	}
+}
+
+// advanceStep is SetNextStep returning why the game didn't advance
+func (store *Store) advanceStep(gameId string, fromStep string) error {
+	return store.withGameLock(gameId, "step", func() error {
+		return store.setNextStep(gameId, fromStep)
+	})
+}
+
+// moveStep sets the game's current step in a transaction, ErrStepChanged when it is no longer from
+func (store *Store) moveStep(gameId string, from string, to string) error {
+
+	return store.TransactionPath(context.Background(), "games/"+gameId+"/current_step", func(t db.TransactionNode) (interface{}, error) {
+		var current string
+		if err := t.Unmarshal(&current); err != nil {
+			return nil, err
+		}
+		if current != from {
+			return nil, fmt.Errorf("%w: game %s is at step %q, not %q", ErrStepChanged, gameId, current, from)
+		}
+		return to, nil
+	})
+}
+
+func (store *Store) setNextStep(gameId string, fromStep string) error {
 
 	// find game
 	game, err := store.GetByBin(gameId, "games")
 	if err != nil {
-		return
+		return err
 	}
 
 	//parse game into a Game struct object
 	g := game.(*models.Game)
 
+	if g.CurrentStep != fromStep {
+		return fmt.Errorf("%w: game %s is at step %q, not %q", ErrStepChanged, gameId, g.CurrentStep, fromStep)
+	}
+
 	// get the current step
 	currentStep, err := store.GetStepByBin(g.CurrentStep)
 	if err != nil {
-		return
+		return err
 	}
 
 	// set the game's current step
+	if err := store.moveStep(gameId, fromStep, currentStep.Bin); err != nil {
+		return err
+	}
 	g.CurrentStep = currentStep.Bin
+	store.logAction(gameId, &GameAction{Type: ActionStepAdvance, StepBin: g.CurrentStep})
+	store.publish(&DomainEvent{Type: EventStepAdvanced, GameId: gameId, Data: map[string]interface{}{"step": g.CurrentStep}})
 
-	// update the game
-	err = store.Update(gameId, map[string]interface{}{
-		"current_step": g.CurrentStep,
-	}, "games")
-	if err != nil {
-		return
+	// looping back to the first step starts a new cycle
+	if g.FirstStepBin != "" && g.CurrentStep == g.FirstStepBin {
+		if _, err := store.advanceCycle(gameId); err != nil {
+			log.Printf("Error advancing cycle of game %s: %v", gameId, err)
+		}
 	}
 
-	return
+	return nil
 }
 
 func (store *Store) AddAllCharactersToDb(chars map[string]*models.GameCharacter) error {
-
-	for _, s := range chars {
-		//s.Bin = strconv.Itoa(i)
-		err := store.Create(s, "characters")
-		if err != nil {
-			return err
-		}
-	}
-	return nil
+	return store.AddAllCharactersToDbContext(context.Background(), chars)
 }
 
 func (store *Store) AddAllCharactersToGame(gameId string, chars map[string]*models.GameCharacter) error {
 
-	if err := store.NewRef("games/"+gameId+"/characters/").Set(context.Background(), chars); err != nil {
+	if err := store.SetPath(context.Background(), "games/"+gameId+"/characters/", chars); err != nil {
 		return err
 	}
 
@@ -829,15 +1148,7 @@ func (store *Store) AddAllCharactersToGame(gameId string, chars map[string]*mode
 }
 
 func (store *Store) AddAllStepsToDb(chars map[string]*models.Step) error {
-
-	for _, s := range chars {
-		//s.Bin = strconv.Itoa(i)
-		err := store.Create(s, "steps")
-		if err != nil {
-			return err
-		}
-	}
-	return nil
+	return store.AddAllStepsToDbContext(context.Background(), chars)
 }
 
 func (store *Store) InitializeGame(game *models.Game) {
@@ -848,41 +1159,41 @@ func (store *Store) InitializeGame(game *models.Game) {
 	}
 }
 
-func (store *Store) AddRandomUsers(userNames []string, photoUrls []string) (bool, error) {
-
-	// generate users
-	for _, un := range userNames {
-		err := store.Create(&models.Player{
-			UserName: un,
-			Bin:      uuid.New().String(),
-			Photo:    photoUrls[rand.Intn(len(photoUrls))],
-			Status:   "available",
-			Privacy:  "public",
-		}, "player")
-		if err != nil {
-			return false, err
-		}
-	}
-
-	return true, nil
+// AddRandomUsers creates a player for every user name with a random photo and returns them.
+//
+// Deprecated: use GenerateRandomUsers with a seeded source, or fixtures.Loader for fixed data.
+func (store *Store) AddRandomUsers(userNames []string, photoUrls []string) ([]*models.Player, error) {
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	return store.GenerateRandomUsers(rng, RandomUserOptions{UserNames: userNames, Photos: photoUrls})
 }
 
 func (store *Store) CreateGameGroup(groupName string, cap int, ownerId string, userIds []string) (bool, error) {
 
 	// find all users and build a user object for each
-	var users map[string]*models.Player
+	users := make(map[string]*models.Player, len(userIds))
 	for _, uId := range userIds {
-		var u, _ = store.GetByBin(uId, "players")
-		user := u.(*models.Player)
-		users[user.Bin] = user
+		u, err := store.GetByBin(uId, "players")
+		if err != nil {
+			return false, err
+		}
+		if user, ok := u.(*models.Player); ok && user.Bin != "" {
+			users[user.Bin] = user
+		}
 	}
 
-	owner, _ := store.GetByBin(ownerId, "players")
+	o, err := store.GetByBin(ownerId, "players")
+	if err != nil {
+		return false, err
+	}
+	owner, ok := o.(*models.Player)
+	if !ok {
+		return false, invalidType(o, "players")
+	}
 
 	// create a group
-	err := store.Create(&models.Group{
+	err = store.Create(&models.Group{
 		Bin:       uuid.New().String(),
-		Creator:   owner.(*models.Player),
+		Creator:   owner,
 		Members:   users,
 		GroupName: groupName,
 		Capacity:  cap,
@@ -916,16 +1227,30 @@ func (store *Store) AddPlayerToGroup(playerId string, groupId string) {
 	// parse player into a Player struct object
 	p := player.(*models.Player)
 
-	// add player to the game group's members array
-	g.Members[p.Bin] = p
+	// players who blocked a member, or were blocked by one, can't join
+	members, err := store.GetShallowKeys("game_groups/" + groupId + "/members")
+	if err != nil {
+		return
+	}
+	if err := store.checkNotBlocked(p.Bin, members); err != nil {
+		log.Printf("Error adding player to group %s: %v", groupId, err)
+		return
+	}
 
-	// update the game group
-	err = store.Update(groupId, map[string]interface{}{
-		"members": g.Members,
-	}, "game_groups")
+	// a full group puts the player on its waiting list instead
+	if g.Capacity > 0 && len(members) >= g.Capacity {
+		log.Printf("Error adding player to group %s: %v", groupId, store.waitlistMember(groupId, p.Bin))
+		return
+	}
+
+	// add player to the game group's members, as a plain member
+	err = store.UpdateGameGroup(g.Bin, map[string]interface{}{
+		"members/" + p.Bin: memberNode(p, RoleMember),
+	})
 	if err != nil {
 		return
 	}
+	store.addPlayerRef(p.Bin, "groups", g.Bin)
 
 	return
 }
@@ -941,22 +1266,14 @@ func (store *Store) RemovePlayerFromGroup(playerId string, groupId string) {
 	// parse game group into a Group struct object
 	g := gameGroup.(*models.Group)
 
-	// remove player from the game group's members array
-	for _, m := range g.Members {
-		if m.Bin == playerId {
-			// remove player  m from g.Members
-			delete(g.Members, m.Bin)
-			break
-		}
-	}
-
-	// update the game group
-	err = store.Update(groupId, map[string]interface{}{
-		"members": g.Members,
-	}, "game_groups")
+	// remove player from the game group's members, leaving the other members' roles alone
+	err = store.UpdateGameGroup(g.Bin, map[string]interface{}{
+		"members/" + playerId: nil,
+	})
 	if err != nil {
 		return
 	}
+	store.promoteGroupWaitlist(g.Bin)
 
 	return
 }
@@ -983,9 +1300,8 @@ func (store *Store) InvitePlayerToGroup(playerId string, invitation *models.Invi
 
 func (store *Store) InvitePlayerToGame(playerId string, invitation models.Invitation) (bool, error) {
 
-	// update the player
-	err := store.AddInvitationToPlayer(playerId, invitation.Bin, &invitation)
-	if err != nil {
+	// blocked players can't invite each other
+	if err := store.checkNotBlocked(playerId, []string{invitation.CreatorId}); err != nil {
 		return false, err
 	}
 
@@ -996,7 +1312,13 @@ func (store *Store) InvitePlayerToGame(playerId string, invitation models.Invita
 	}
 
 	//  convert
-	plr := p.(models.Player)
+	plr, ok := p.(*models.Player)
+	if !ok {
+		return false, invalidType(p, "players")
+	}
+	if plr.Bin == "" {
+		return false, fmt.Errorf("player not found: %s", playerId)
+	}
 
 	//add the invitation to the player's list of invites
 	err = store.AddInvitationToPlayer(plr.Bin, invitation.Bin, &invitation)
@@ -1111,8 +1433,17 @@ func (store *Store) AcceptGroupInvitation(p *models.Player, invitationId string,
 	// parse game group into a Group struct object
 	g := gameGroup.(*models.Group)
 
+	// a full group puts the player on its waiting list instead
+	full, err := store.groupIsFull(g.Bin)
+	if err != nil {
+		return false, err
+	}
+	if full {
+		return false, store.waitlistMember(g.Bin, p.Bin)
+	}
+
 	// add player to the member list
-	err = store.AddPlayerToGroupMembers(groupId, g.Bin, p)
+	err = store.AddPlayerToGroupMembers(g.Bin, p.Bin, p)
 	if err != nil {
 		return false, err
 	}
@@ -1150,9 +1481,11 @@ func (store *Store) DeclineGameGroupInvitation(p *models.Player, invitationId st
 	g := gameGroup.(*models.Group)
 
 	// remove player from the game group's members array
+	removed := false
 	for _, m := range g.Members {
 		if m.Bin == p.Bin {
 			delete(g.Members, p.Bin)
+			removed = true
 			break
 		}
 	}
@@ -1164,6 +1497,9 @@ func (store *Store) DeclineGameGroupInvitation(p *models.Player, invitationId st
 	if err != nil {
 		return
 	}
+	if removed {
+		store.promoteGroupWaitlist(groupId)
+	}
 
 	return
 }
@@ -1190,6 +1526,21 @@ func (store *Store) StartGame(gameId string) (bool, error) {
 	//parse game into a Game struct object
 	g := game.(*models.Game)
 
+	// check readiness when the rules ask for it
+	rules, err := store.GetGameRuleset(gameId)
+	if err != nil {
+		return false, err
+	}
+	if rules.RequireReady {
+		ready, unready, err := store.AllReady(gameId)
+		if err != nil {
+			return false, err
+		}
+		if !ready {
+			return false, fmt.Errorf("gamers are not ready: %v", unready)
+		}
+	}
+
 	// set the game's status to start
 	g.Status = "started"
 
@@ -1200,6 +1551,10 @@ func (store *Store) StartGame(gameId string) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	store.refreshGameSummary(gameId, map[string]interface{}{"started_at": time.Now().UTC().Format(time.RFC3339)})
+	store.publish(&DomainEvent{Type: EventGameStarted, GameId: gameId, GroupId: g.GroupId})
+	store.claimPlayers(g)
+	store.logFeed(gameId, &FeedEvent{Type: FeedGameStarted})
 
 	return true, nil
 }
@@ -1227,6 +1582,18 @@ func (store *Store) EndGame(gameId string) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	if err := store.ClearHeartbeat(gameId); err != nil {
+		log.Printf("Error clearing the heartbeat of game %s: %v", gameId, err)
+	}
+	if err := store.DeletePath(context.Background(), "allocations/"+gameId); err != nil {
+		log.Printf("Error releasing the allocation of game %s: %v", gameId, err)
+	}
+	store.recordTeamStats(gameId)
+	store.recordGameResults(g)
+	store.releasePlayers(g)
+	store.publish(&DomainEvent{Type: EventGameEnded, GameId: gameId, GroupId: g.GroupId})
+	store.logFeed(gameId, &FeedEvent{Type: FeedGameEnded})
+	store.materializeNextOccurrence(gameId)
 
 	return true, nil
 }
@@ -1243,6 +1610,19 @@ func (store *Store) Vote(vote *models.Vote) bool {
 		return false
 	}
 
+	// check the vote against the game's ruleset
+	rules, err := store.GetGameRuleset(game.Bin)
+	if err != nil {
+		log.Printf("Error getting game ruleset: %v", err)
+		return false
+	}
+	if step := game.Steps[game.CurrentStep]; step != nil {
+		if err := rules.CanVote(vote, step.Result[vote.Source]); err != nil {
+			log.Printf("Vote rejected: %v", err)
+			return false
+		}
+	}
+
 	// add vote action to the Results map for that step and the current cycle
 	var mp = buildStepResult(game, vote.Source, vote)
 
@@ -1252,6 +1632,7 @@ func (store *Store) Vote(vote *models.Vote) bool {
 	if err != nil {
 		return false
 	}
+	store.logAction(game.Bin, &GameAction{Type: ActionVote, GamerId: vote.Source, Target: vote.Target, StepBin: game.CurrentStep, Ability: vote.Ability})
 
 	// check if the bot's character is alive
 	log.Printf("voted")
@@ -1282,66 +1663,165 @@ func buildStepResult(game *models.Game, gamerId string, action *models.Vote) *ma
 		Vote:      *action,
 	})
 
-	//build update map
+	//build update map, the step shows the current cycle and cycle_results keeps every cycle
+	results := game.Steps[game.CurrentStep].Result[gamerId]
 	return &map[string]interface{}{
-		"steps/" + game.CurrentStep + "/result/" + gamerId: game.Steps[game.CurrentStep].Result[gamerId],
+		"steps/" + game.CurrentStep + "/result/" + gamerId:                                     results,
+		"cycle_results/" + cycleKey(game.NightCycles) + "/" + game.CurrentStep + "/" + gamerId: results,
 	}
 }
 
-func (store *Store) ArchiveStepResults(gameId string) error {
-	// get the game
-	g, err := store.GetByBin(gameId, "games")
-	if err != nil {
-		return err
-	}
+// StepResultsArchive summarises one ArchiveStepResults run
+type StepResultsArchive struct {
+	GameId  string   `json:"game_id"`
+	Steps   []string `json:"steps"`   // steps whose results were moved
+	Results int      `json:"results"` // results moved
+	Gamers  int      `json:"gamers"`  // gamers with moved results
+}
 
-	game := g.(*models.Game)
+// ArchiveStepResults moves the results of every step into the game's aggregated results, keyed by
+// gamer. Results of earlier cycles are copied from cycle_results, which is kept. Once the aggregate
+// is written each step drops, in a transaction, only the results that were archived, so votes cast
+// meanwhile stay on the step. Results already in the aggregate are not added twice, a failure
+// between the two writes leaves copies the next run skips.
+func (store *Store) ArchiveStepResults(gameId string) (*StepResultsArchive, error) {
+
+	ctx := context.Background()
+	var steps map[string]*models.Step
+	if err := store.GetPath(ctx, "games/"+gameId+"/steps", &steps); err != nil {
+		return nil, err
+	}
+	var aggregated map[string][]*models.Result
+	if err := store.GetPath(ctx, "games/"+gameId+"/result", &aggregated); err != nil {
+		return nil, err
+	}
+	if aggregated == nil {
+		aggregated = make(map[string][]*models.Result)
+	}
 
-	if game.StepResults == nil {
-		game.StepResults = make(map[string][]*models.Result)
+	seen := make(map[string]bool)
+	for _, results := range aggregated {
+		for _, r := range results {
+			if r != nil && r.Bin != "" {
+				seen[r.Bin] = true
+			}
+		}
 	}
 
-	for _, step := range game.Steps {
-		// check is the step has the result node first
-		if step.Result != nil {
-			// add the step's results to the game's result node with the gamer's bin from the result as the key
-			for _, result := range step.Result {
-				for _, res := range result {
-					game.StepResults[res.GamerId] = append(game.StepResults[res.GamerId], res)
+	summary := &StepResultsArchive{GameId: gameId}
+	changed := make(map[string]bool)
+	archive := func(stepId string, byGamer map[string][]*models.Result) {
+		for gamerId, results := range byGamer {
+			for _, r := range results {
+				if r == nil || (r.Bin != "" && seen[r.Bin]) {
+					continue
 				}
+				if r.GamerId == "" {
+					r.GamerId = gamerId
+				}
+				if r.StepBin == "" {
+					r.StepBin = stepId
+				}
+				seen[r.Bin] = true
+				aggregated[r.GamerId] = append(aggregated[r.GamerId], r)
+				changed[r.GamerId] = true
+				summary.Results++
 			}
 		}
 	}
 
-	//publish the changes to the game node
-	err = store.Update(gameId, map[string]interface{}{
-		"steps":        game.Steps,
-		"step_results": game.StepResults,
-	}, "games")
+	cycles, err := store.getAllCycleResults(ctx, gameId)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	for _, byStep := range cycles {
+		for stepId, byGamer := range byStep {
+			archive(stepId, byGamer)
+		}
+	}
+	archived := make(map[string]map[string]bool)
+	for stepId, step := range steps {
+		if step == nil || len(step.Result) == 0 {
+			continue
+		}
+		archived[stepId] = make(map[string]bool)
+		for gamerId, results := range step.Result {
+			for _, r := range results {
+				if r != nil {
+					archived[stepId][resultKey(gamerId, r)] = true
+				}
+			}
+		}
+		archive(stepId, step.Result)
+		summary.Steps = append(summary.Steps, stepId)
+	}
+	if len(changed) == 0 && len(summary.Steps) == 0 {
+		return summary, nil
+	}
+	sort.Strings(summary.Steps)
 
-	return nil
+	m := make(map[string]interface{})
+	for gamerId := range changed {
+		sortResults(aggregated[gamerId])
+		m["result/"+gamerId] = aggregated[gamerId]
+	}
+	summary.Gamers = len(changed)
+
+	if len(m) > 0 {
+		if err := store.UpdateGame(gameId, m); err != nil {
+			return nil, err
+		}
+	}
+	for _, stepId := range summary.Steps {
+		keys := archived[stepId]
+		err := store.TransactionPath(ctx, "games/"+gameId+"/steps/"+stepId+"/result", func(t db.TransactionNode) (interface{}, error) {
+			var byGamer map[string][]*models.Result
+			if err := t.Unmarshal(&byGamer); err != nil {
+				return nil, err
+			}
+			left := make(map[string][]*models.Result)
+			for gamerId, results := range byGamer {
+				for _, r := range results {
+					if r != nil && !keys[resultKey(gamerId, r)] {
+						left[gamerId] = append(left[gamerId], r)
+					}
+				}
+			}
+			if len(left) == 0 {
+				return nil, nil
+			}
+			return left, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return summary, nil
 }
 
-func (store *Store) ApplyAbility(abilityBin string, gameBin string, targetGamer string) {
-	// construct a models.Fate struct from the ability
-	fate := &models.Fate{
-		Bin:        uuid.New().String(),
-		AbilityBin: abilityBin,
+// resultKey identifies a step result, by its bin or, for results written without one, by gamer and time
+func resultKey(gamerId string, r *models.Result) string {
+	if r.Bin != "" {
+		return r.Bin
 	}
+	return gamerId + "|" + r.TimeStamp
+}
 
-	// add the fate to the targetGamer
-	err := store.NewRef("games/"+gameBin+"/gamers/"+targetGamer+"/fate").Set(context.Background(), fate)
-	if err != nil {
+func (store *Store) ApplyAbility(abilityBin string, gameBin string, targetGamer string) {
+	store.ApplyAbilityFrom(abilityBin, gameBin, "", targetGamer)
+}
+
+// ApplyAbilityFrom queues the ability for the current cycle, the target's fate is set by ResolveFates
+func (store *Store) ApplyAbilityFrom(abilityBin string, gameBin string, sourceGamer string, targetGamer string) {
+	// add the fate to the cycle's pending fates
+	if _, err := store.QueueFate(abilityBin, gameBin, sourceGamer, targetGamer); err != nil {
 		log.Printf("Error adding fate to gamer: %v", err)
 	}
 }
 
 func (store *Store) AddMessageToGame(msg *models.Message, gameId string) error {
 
-	if err := store.NewRef("games/"+gameId+"/messages/"+msg.Timestamp).Set(context.Background(), msg); err != nil {
+	if err := store.SetPath(context.Background(), "games/"+gameId+"/messages/"+msg.Timestamp, msg); err != nil {
 		return err
 	}
 	return nil
@@ -1350,7 +1830,7 @@ func (store *Store) AddMessageToGame(msg *models.Message, gameId string) error {
 func (store *Store) GetPlayerToken(bin string) (*string, error) {
 
 	var token *string
-	if err := store.NewRef("players/"+bin+"/token").Get(context.Background(), &token); err != nil {
+	if err := store.GetPath(context.Background(), "players/"+bin+"/token", &token); err != nil {
 		return nil, err
 	}
 	return token, nil
@@ -0,0 +1,190 @@
+package v1
+
+import (
+	"context"
+	models "github.com/horcu/pm-models/types"
+	"log"
+	"strings"
+	"time"
+)
+
+// GameSummary is the lobby listing entry of a game, kept in sync under game_summaries/{gameId}
+type GameSummary struct {
+	Bin         string `json:"bin"`
+	Name        string `json:"name,omitempty"`
+	Status      string `json:"status"`
+	StatusKey   string `json:"status_key"` // status and bin, lets listings filter by status and page by bin
+	PlayerCount int    `json:"player_count"`
+	HostId      string `json:"host_id,omitempty"`
+	HostName    string `json:"host_name,omitempty"`
+	GroupId     string `json:"group_id,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	StartedAt   string `json:"started_at,omitempty"`
+	Private     bool   `json:"private,omitempty"` // joinable by code only
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// SummaryListOptions pages through game summaries
+type SummaryListOptions struct {
+	Status string // only list games with this status, empty lists all
+	Limit  int    // page size, defaults to 20
+	Cursor string // bin of the last summary of the previous page
+}
+
+// game update keys that change the lobby listing
+var summaryKeys = []string{"status", "creator", "info", "gamers", "group_id"}
+
+func statusKey(status string, bin string) string {
+	return status + "|" + bin
+}
+
+// statusKeyEnd is the upper bound of the status keys of a status, after every bin
+func statusKeyEnd(status string) string {
+	return status + "|\uf8ff"
+}
+
+func summaryOf(g *models.Game) *GameSummary {
+	s := &GameSummary{
+		Bin:         g.Bin,
+		Status:      g.Status,
+		StatusKey:   statusKey(g.Status, g.Bin),
+		PlayerCount: len(g.Gamers),
+		GroupId:     g.GroupId,
+		UpdatedAt:   time.Now().UTC().Format(time.RFC3339),
+	}
+	if g.Info != nil {
+		s.Name = g.Info.Name
+	}
+	if g.Creator != nil {
+		s.HostId = g.Creator.Bin
+		s.HostName = g.Creator.UserName
+	}
+	return s
+}
+
+// touchesSummary reports whether a game update changes fields shown in the lobby
+func touchesSummary(m map[string]interface{}) bool {
+	for k := range m {
+		for _, key := range summaryKeys {
+			if k == key || strings.HasPrefix(k, key+"/") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// createGameSummary writes the first summary of a newly created game
+func (store *Store) createGameSummary(g *models.Game, private bool) {
+	s := summaryOf(g)
+	s.CreatedAt = s.UpdatedAt
+	s.Private = private
+	if err := store.SetPath(context.Background(), "game_summaries/"+g.Bin, s); err != nil {
+		log.Printf("Error writing game summary for %s: %v", g.Bin, err)
+	}
+}
+
+// refreshGameSummary rebuilds the summary from the game's listing fields only, counting gamers with a shallow read
+func (store *Store) refreshGameSummary(gameId string, extra map[string]interface{}) {
+
+	ctx := context.Background()
+	var status string
+	var creator *models.Player
+	var info *models.ServerInfo
+	var groupId string
+	var gamers map[string]interface{}
+	if err := store.GetPath(ctx, "games/"+gameId+"/status", &status); err != nil {
+		log.Printf("Error refreshing game summary for %s: %v", gameId, err)
+		return
+	}
+	if status == "" {
+		// the game is gone
+		store.deleteGameSummary(gameId)
+		return
+	}
+	_ = store.GetPath(ctx, "games/"+gameId+"/creator", &creator)
+	_ = store.GetPath(ctx, "games/"+gameId+"/info", &info)
+	_ = store.GetPath(ctx, "games/"+gameId+"/group_id", &groupId)
+	if err := store.GetShallowPath(ctx, "games/"+gameId+"/gamers", &gamers); err != nil {
+		log.Printf("Error refreshing game summary for %s: %v", gameId, err)
+		return
+	}
+
+	s := summaryOf(&models.Game{Bin: gameId, Status: status, Creator: creator, Info: info, GroupId: groupId})
+	m := map[string]interface{}{
+		"bin":          s.Bin,
+		"name":         s.Name,
+		"status":       s.Status,
+		"status_key":   s.StatusKey,
+		"player_count": len(gamers),
+		"host_id":      s.HostId,
+		"host_name":    s.HostName,
+		"group_id":     s.GroupId,
+		"updated_at":   s.UpdatedAt,
+	}
+	for k, v := range extra {
+		m[k] = v
+	}
+	if err := store.UpdatePath(ctx, "game_summaries/"+gameId, m); err != nil {
+		log.Printf("Error refreshing game summary for %s: %v", gameId, err)
+	}
+}
+
+func (store *Store) deleteGameSummary(gameId string) {
+	if err := store.DeletePath(context.Background(), "game_summaries/"+gameId); err != nil {
+		log.Printf("Error deleting game summary for %s: %v", gameId, err)
+	}
+}
+
+// GetGameSummary returns the lobby summary of a game or nil
+func (store *Store) GetGameSummary(gameId string) (*GameSummary, error) {
+
+	var s *GameSummary
+	if err := store.GetPath(context.Background(), "game_summaries/"+gameId, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// ListGameSummaries returns a page of summaries ordered by bin and the cursor of the next page, empty on the last page
+func (store *Store) ListGameSummaries(opts SummaryListOptions) ([]*GameSummary, string, error) {
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+
+	ref := store.NewRef("game_summaries")
+	var q = ref.OrderByKey()
+	if opts.Status != "" {
+		start := statusKey(opts.Status, opts.Cursor)
+		q = ref.OrderByChild("status_key").StartAt(start).EndAt(statusKeyEnd(opts.Status))
+	} else if opts.Cursor != "" {
+		q = q.StartAt(opts.Cursor)
+	}
+
+	// one extra to skip the cursor itself and one to know whether there is a next page
+	nodes, err := store.GetOrderedQuery(context.Background(), "game_summaries", q.LimitToFirst(limit+2))
+	if err != nil {
+		return nil, "", err
+	}
+
+	var page []*GameSummary
+	for _, n := range nodes {
+		if n.Key() == opts.Cursor {
+			continue
+		}
+		s := &GameSummary{}
+		if err := n.Unmarshal(s); err != nil {
+			return nil, "", err
+		}
+		page = append(page, s)
+	}
+
+	next := ""
+	if len(page) > limit {
+		page = page[:limit]
+		next = page[limit-1].Bin
+	}
+	return page, next, nil
+}
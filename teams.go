@@ -0,0 +1,206 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+	"log"
+	"sort"
+)
+
+// team win conditions
+const (
+	TeamWinLastStanding = "last_standing" // every other team is eliminated
+	TeamWinParity       = "parity"        // living members at least match all other living gamers
+)
+
+// Team is a side in a team game, stored under games/{id}/teams/{bin}
+type Team struct {
+	Bin          string   `json:"bin"`
+	Name         string   `json:"name"`
+	Members      []string `json:"members"` // gamer bins
+	WinCondition string   `json:"win_condition,omitempty"`
+}
+
+// TeamStats is a team's result, written under games/{id}/team_stats/{bin} when the game ends
+type TeamStats struct {
+	Team  string `json:"team"`
+	Alive int    `json:"alive"`
+	Dead  int    `json:"dead"`
+	Won   bool   `json:"won"`
+}
+
+// SetGameTeams replaces the teams of a game, a gamer can only be on one team
+func (store *Store) SetGameTeams(gameId string, teams []*Team) error {
+
+	seen := make(map[string]string)
+	m := make(map[string]*Team, len(teams))
+	for _, t := range teams {
+		if t == nil || t.Bin == "" {
+			return fmt.Errorf("invalid team object")
+		}
+		switch t.WinCondition {
+		case "":
+			t.WinCondition = TeamWinLastStanding
+		case TeamWinLastStanding, TeamWinParity:
+		default:
+			return fmt.Errorf("invalid win condition: %s", t.WinCondition)
+		}
+		for _, g := range t.Members {
+			if other, ok := seen[g]; ok {
+				return fmt.Errorf("gamer %s is on teams %s and %s", g, other, t.Bin)
+			}
+			seen[g] = t.Bin
+		}
+		m[t.Bin] = t
+	}
+	return store.SetPath(context.Background(), "games/"+gameId+"/teams", m)
+}
+
+// GetGameTeams returns the teams of a game, nil for games without teams
+func (store *Store) GetGameTeams(gameId string) (map[string]*Team, error) {
+
+	var m map[string]*Team
+	if err := store.GetPath(context.Background(), "games/"+gameId+"/teams", &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetGamerTeam returns the team a gamer is on, nil when none
+func (store *Store) GetGamerTeam(gameId string, gamerId string) (*Team, error) {
+
+	teams, err := store.GetGameTeams(gameId)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range teams {
+		if t != nil && contains(t.Members, gamerId) {
+			return t, nil
+		}
+	}
+	return nil, nil
+}
+
+// AddTeamMessage posts to a team's private channel under games/{id}/team_chat/{team}, only its members can post
+func (store *Store) AddTeamMessage(gameId string, teamId string, msg *models.Message) error {
+
+	if msg == nil || msg.Timestamp == "" {
+		return fmt.Errorf("invalid message object")
+	}
+	t, err := store.GetGamerTeam(gameId, msg.Source)
+	if err != nil {
+		return err
+	}
+	if t == nil || t.Bin != teamId {
+		return fmt.Errorf("gamer %s is not on team %s", msg.Source, teamId)
+	}
+	return store.SetPath(context.Background(), "games/"+gameId+"/team_chat/"+teamId+"/"+msg.Timestamp, msg)
+}
+
+// GetTeamMessages returns a team's channel
+func (store *Store) GetTeamMessages(gameId string, teamId string) (map[string]*models.Message, error) {
+
+	var m map[string]*models.Message
+	if err := store.GetPath(context.Background(), "games/"+gameId+"/team_chat/"+teamId, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// teamAlive counts the living members of every team
+func (store *Store) teamAlive(gameId string, teams map[string]*Team) (map[string]int, map[string]int, error) {
+
+	alive := make(map[string]int, len(teams))
+	dead := make(map[string]int, len(teams))
+	for bin, t := range teams {
+		if t == nil {
+			continue
+		}
+		for _, g := range t.Members {
+			var isAlive bool
+			if err := store.GetPath(context.Background(), "games/"+gameId+"/gamers/"+g+"/is_alive", &isAlive); err != nil {
+				return nil, nil, err
+			}
+			if isAlive {
+				alive[bin]++
+			} else {
+				dead[bin]++
+			}
+		}
+	}
+	return alive, dead, nil
+}
+
+// EvaluateTeamWin applies each team's win condition and returns the winning team, if one has won
+func (store *Store) EvaluateTeamWin(gameId string) (string, bool, error) {
+
+	teams, err := store.GetGameTeams(gameId)
+	if err != nil || len(teams) == 0 {
+		return "", false, err
+	}
+	alive, _, err := store.teamAlive(gameId, teams)
+	if err != nil {
+		return "", false, err
+	}
+
+	total := 0
+	for _, n := range alive {
+		total += n
+	}
+	// in bin order so simultaneous conditions resolve the same way every time
+	bins := make([]string, 0, len(teams))
+	for bin := range teams {
+		bins = append(bins, bin)
+	}
+	sort.Strings(bins)
+	for _, bin := range bins {
+		t := teams[bin]
+		if t == nil || alive[bin] == 0 {
+			continue
+		}
+		others := total - alive[bin]
+		switch t.WinCondition {
+		case TeamWinParity:
+			if alive[bin] >= others {
+				return bin, true, nil
+			}
+		default:
+			if others == 0 {
+				return bin, true, nil
+			}
+		}
+	}
+	return "", false, nil
+}
+
+// recordTeamStats writes the team results of an ended team game
+func (store *Store) recordTeamStats(gameId string) {
+
+	teams, err := store.GetGameTeams(gameId)
+	if err != nil {
+		log.Printf("Error recording team stats for %s: %v", gameId, err)
+		return
+	}
+	if len(teams) == 0 {
+		return
+	}
+	alive, dead, err := store.teamAlive(gameId, teams)
+	if err != nil {
+		log.Printf("Error recording team stats for %s: %v", gameId, err)
+		return
+	}
+	winner, _, err := store.EvaluateTeamWin(gameId)
+	if err != nil {
+		log.Printf("Error recording team stats for %s: %v", gameId, err)
+		return
+	}
+
+	stats := make(map[string]*TeamStats, len(teams))
+	for bin := range teams {
+		stats[bin] = &TeamStats{Team: bin, Alive: alive[bin], Dead: dead[bin], Won: bin == winner}
+	}
+	if err := store.SetPath(context.Background(), "games/"+gameId+"/team_stats", stats); err != nil {
+		log.Printf("Error recording team stats for %s: %v", gameId, err)
+	}
+}
@@ -0,0 +1,126 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	models "github.com/horcu/pm-models/types"
+	"time"
+)
+
+// GameTemplate is a reusable game configuration stored under game_templates
+type GameTemplate struct {
+	Bin             string                           `json:"bin"`
+	Name            string                           `json:"name"`
+	CreatorId       string                           `json:"creator_id,omitempty"`
+	FirstStepBin    string                           `json:"first_step_bin,omitempty"`
+	GameOverStepBin string                           `json:"gameover_step_bin,omitempty"`
+	Steps           map[string]*models.Step          `json:"steps,omitempty"`
+	Characters      map[string]*models.GameCharacter `json:"characters,omitempty"`
+	Abilities       map[string]*models.Ability       `json:"abilities,omitempty"`
+	Settings        map[string]interface{}           `json:"settings,omitempty"`
+	CreatedAt       string                           `json:"created_at,omitempty"`
+}
+
+// CreateGameTemplate stores a template, assigning it a bin when it has none
+func (store *Store) CreateGameTemplate(t *GameTemplate) error {
+
+	if t == nil {
+		return fmt.Errorf("invalid template object")
+	}
+	if t.Bin == "" {
+		t.Bin = uuid.New().String()
+	}
+	if t.CreatedAt == "" {
+		t.CreatedAt = time.Now().UTC().Format(time.RFC3339)
+	}
+	return store.SetPath(context.Background(), "game_templates/"+t.Bin, t)
+}
+
+// GetGameTemplate returns the template with the given bin or nil if it does not exist
+func (store *Store) GetGameTemplate(bin string) (*GameTemplate, error) {
+
+	var t *GameTemplate
+	if err := store.GetPath(context.Background(), "game_templates/"+bin, &t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// DeleteGameTemplate removes a template
+func (store *Store) DeleteGameTemplate(bin string) error {
+
+	return store.DeletePath(context.Background(), "game_templates/"+bin)
+}
+
+// InstantiateGameFromTemplate creates a new game from a template. The game and its steps get fresh
+// bins, step links are rewired to the new bins and the catalog characters and abilities are copied in.
+func (store *Store) InstantiateGameFromTemplate(templateId string, creator *models.Player, groupId string) (*models.Game, error) {
+	return store.instantiateTemplate(templateId, creator, groupId, "")
+}
+
+// instantiateTemplate creates a game from a template in region, empty for no region
+func (store *Store) instantiateTemplate(templateId string, creator *models.Player, groupId string, region string) (*models.Game, error) {
+
+	t, err := store.GetGameTemplate(templateId)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, fmt.Errorf("template not found: %s", templateId)
+	}
+
+	// map the template step bins to fresh ones
+	bins := make(map[string]string, len(t.Steps))
+	for bin := range t.Steps {
+		bins[bin] = uuid.New().String()
+	}
+
+	steps := make(map[string]*models.Step, len(t.Steps))
+	for bin, s := range t.Steps {
+		if s == nil {
+			continue
+		}
+		step := *s
+		step.Bin = bins[bin]
+		if next, ok := bins[s.NextStep]; ok {
+			step.NextStep = next
+		}
+		step.Result = nil
+		step.YetToVote = nil
+		steps[step.Bin] = &step
+	}
+
+	game := &models.Game{
+		Bin:             uuid.New().String(),
+		GroupId:         groupId,
+		FirstStepBin:    bins[t.FirstStepBin],
+		GameOverStepBin: bins[t.GameOverStepBin],
+		CurrentStep:     bins[t.FirstStepBin],
+		Status:          "waiting",
+		Creator:         creator,
+		Steps:           steps,
+		Characters:      t.Characters,
+	}
+
+	if err := store.CreateGameInRegion(game, region); err != nil {
+		return nil, err
+	}
+	if len(t.Abilities) > 0 {
+		if err := store.AddAbilitiesToGame(game.Bin, t.Abilities); err != nil {
+			return nil, err
+		}
+	}
+
+	m := map[string]interface{}{
+		"template_bin": t.Bin,
+	}
+	if len(t.Settings) > 0 {
+		m["settings"] = t.Settings
+	}
+	if err := store.UpdateGame(game.Bin, m); err != nil {
+		return nil, err
+	}
+
+	return game, nil
+}
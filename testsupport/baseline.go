@@ -0,0 +1,27 @@
+package testsupport
+
+import (
+	models "github.com/horcu/pm-models/types"
+	"github.com/horcu/pm-store/fixtures"
+)
+
+// Baseline returns the catalog every test store is seeded with: a villain and two innocent
+// characters with their abilities and a day and night step cycle
+func Baseline() *fixtures.Fixtures {
+
+	kill := &models.Ability{Bin: "kill", Name: "kill", Character: "villain", Description: "eliminates a player at night", Frequency: "nightly"}
+	save := &models.Ability{Bin: "save", Name: "save", Character: "doctor", Description: "protects a player for the night", Frequency: "nightly"}
+
+	return &fixtures.Fixtures{
+		Abilities: []*models.Ability{kill, save},
+		Characters: []*models.GameCharacter{
+			{Bin: "villain", Name: "villain", SideId: 1, Abilities: map[string]*models.Ability{"kill": kill}},
+			{Bin: "doctor", Name: "doctor", IsInnocent: true, Abilities: map[string]*models.Ability{"save": save}},
+			{Bin: "villager", Name: "villager", IsInnocent: true},
+		},
+		Steps: []*models.Step{
+			{Bin: "day", StepType: "day", StepIndex: 0, Duration: "120", RequiresVote: true, VoteType: "elimination", NextStep: "night"},
+			{Bin: "night", StepType: "night", StepIndex: 1, Duration: "60", RequiresVote: true, VoteType: "ability", NextStep: "day"},
+		},
+	}
+}
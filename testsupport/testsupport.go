@@ -0,0 +1,164 @@
+// Package testsupport runs integration tests against the realtime database emulator. Every test
+// gets a store namespaced under its own root prefix, seeded with the baseline catalog and deleted
+// again when the test ends, so tests can share one emulator and run in parallel.
+//
+//	func TestMain(m *testing.M) { testsupport.Main(m) }
+//
+//	func TestJoinGame(t *testing.T) {
+//		store := testsupport.NewStore(t)
+//		...
+//	}
+//
+// Tests are skipped when no emulator is configured and the firebase cli isn't installed.
+package testsupport
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	v1 "github.com/horcu/pm-store"
+	"github.com/horcu/pm-store/fixtures"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Namespace is the emulator database the tests write to
+const Namespace = "pm-store-test"
+
+// DefaultPort is the port StartEmulator listens on when asked for 0
+const DefaultPort = 9000
+
+var (
+	connectOnce sync.Once
+	connectErr  error
+	unsafeName  = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+)
+
+// Emulator is a database emulator started by StartEmulator
+type Emulator struct {
+	Host string
+	cmd  *exec.Cmd
+	dir  string
+}
+
+// StartEmulator runs the firebase cli's database emulator on port and waits until it accepts connections
+func StartEmulator(ctx context.Context, port int) (*Emulator, error) {
+
+	if port == 0 {
+		port = DefaultPort
+	}
+	if _, err := exec.LookPath("firebase"); err != nil {
+		return nil, fmt.Errorf("firebase cli not found: %v", err)
+	}
+
+	// the cli reads the port from a firebase.json in its working directory
+	dir, err := os.MkdirTemp("", "pm-store-emulator")
+	if err != nil {
+		return nil, err
+	}
+	config := fmt.Sprintf(`{"emulators": {"database": {"host": "127.0.0.1", "port": %d}}}`, port)
+	if err := os.WriteFile(filepath.Join(dir, "firebase.json"), []byte(config), 0o644); err != nil {
+		return nil, err
+	}
+
+	e := &Emulator{Host: fmt.Sprintf("127.0.0.1:%d", port), dir: dir}
+	e.cmd = exec.Command("firebase", "emulators:start", "--only", "database", "--project", Namespace)
+	e.cmd.Dir = dir
+	if err := e.cmd.Start(); err != nil {
+		return nil, fmt.Errorf("error starting the database emulator: %v", err)
+	}
+
+	for {
+		if conn, err := net.DialTimeout("tcp", e.Host, time.Second); err == nil {
+			conn.Close()
+			return e, nil
+		}
+		select {
+		case <-ctx.Done():
+			_ = e.Stop()
+			return nil, fmt.Errorf("database emulator did not start: %v", ctx.Err())
+		case <-time.After(250 * time.Millisecond):
+		}
+	}
+}
+
+// Stop ends an emulator started by StartEmulator
+func (e *Emulator) Stop() error {
+
+	defer os.RemoveAll(e.dir)
+	if e.cmd == nil || e.cmd.Process == nil {
+		return nil
+	}
+	if err := e.cmd.Process.Signal(os.Interrupt); err != nil {
+		return e.cmd.Process.Kill()
+	}
+	_ = e.cmd.Wait()
+	return nil
+}
+
+// Main runs the package's tests, starting an emulator for them first when FIREBASE_DATABASE_EMULATOR_HOST
+// is unset and the firebase cli is installed. Call it from TestMain.
+func Main(m *testing.M) {
+
+	var e *Emulator
+	if os.Getenv(v1.EmulatorHostEnv) == "" {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		started, err := StartEmulator(ctx, 0)
+		cancel()
+		if err == nil {
+			e = started
+			os.Setenv(v1.EmulatorHostEnv, e.Host)
+		} else {
+			fmt.Fprintln(os.Stderr, "testsupport:", err)
+		}
+	}
+
+	code := m.Run()
+	if e != nil {
+		_ = e.Stop()
+	}
+	os.Exit(code)
+}
+
+// NewStore returns a store connected to the emulator under a root prefix of its own, seeded with
+// the baseline catalog. The prefix is deleted when the test ends. It skips the test when no
+// emulator is configured.
+func NewStore(t testing.TB, opts ...v1.StoreOption) *v1.Store {
+
+	t.Helper()
+	host := os.Getenv(v1.EmulatorHostEnv)
+	if host == "" {
+		t.Skip(v1.EmulatorHostEnv + " is not set, skipping emulator test")
+	}
+
+	// stores share the package's client, so connecting once serves every test
+	connectOnce.Do(func() {
+		connectErr = v1.NewStore().ConnectEmulator(host, Namespace)
+	})
+	if connectErr != nil {
+		t.Fatalf("error connecting to the database emulator at %s: %v", host, connectErr)
+	}
+
+	prefix := "tests/" + unsafeName.ReplaceAllString(t.Name(), "_") + "-" + uuid.New().String()[:8]
+	store := v1.NewStore(append([]v1.StoreOption{v1.WithRootPrefix(prefix)}, opts...)...)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := fixtures.NewLoader(store).Load(ctx, Baseline()); err != nil {
+		t.Fatalf("error seeding the baseline catalog: %v", err)
+	}
+
+	t.Cleanup(func() {
+		// the store's root is its prefix, so this only removes the test's own data
+		if err := store.DeletePath(context.Background(), ""); err != nil {
+			t.Logf("error cleaning up %s: %v", prefix, err)
+		}
+	})
+	return store
+}
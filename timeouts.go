@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"context"
+	"time"
+)
+
+// default per operation timeouts of a store created with NewStore
+const (
+	defaultReadTimeout  = 10 * time.Second
+	defaultWriteTimeout = 15 * time.Second
+)
+
+type opTimeoutKey struct{}
+
+// WithTimeouts sets how long a single read or write may take, zero disables the limit
+func WithTimeouts(read time.Duration, write time.Duration) StoreOption {
+	return func(store *Store) {
+		store.readTimeout = read
+		store.writeTimeout = write
+	}
+}
+
+// WithOpTimeout returns a context overriding the store's default timeout for the calls made with
+// it, zero disables the limit. A deadline already on ctx is always honoured as well.
+func WithOpTimeout(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, opTimeoutKey{}, d)
+}
+
+// opContext bounds a single database call. A call without its own override or deadline gets the
+// store's default read or write timeout. It also holds one of the store's in-flight slots until
+// cancelled, waiting for it at most as long as the timeout.
+func (store *Store) opContext(ctx context.Context, write bool) (context.Context, context.CancelFunc) {
+
+	opCtx, cancel := store.timeoutContext(ctx, write)
+	release := store.limiter.acquire(opCtx)
+	return opCtx, func() {
+		release()
+		cancel()
+	}
+}
+
+func (store *Store) timeoutContext(ctx context.Context, write bool) (context.Context, context.CancelFunc) {
+
+	d, ok := ctx.Value(opTimeoutKey{}).(time.Duration)
+	if !ok {
+		if _, has := ctx.Deadline(); has {
+			return ctx, func() {}
+		}
+		d = store.readTimeout
+		if write {
+			d = store.writeTimeout
+		}
+	}
+	if d <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, d)
+}
@@ -0,0 +1,104 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// FormatTime returns t as the RFC3339 UTC string every stored game time uses
+func FormatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// ParseTime reads a stored time, RFC3339 with any offset or the unix milliseconds older clients
+// wrote, and returns it in UTC
+func ParseTime(s string) (time.Time, error) {
+
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.UTC(), nil
+	}
+	if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.UnixMilli(ms).UTC(), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q, expected RFC3339", s)
+}
+
+// loadTimezone validates an IANA timezone name, empty means UTC
+func loadTimezone(tz string) (*time.Location, error) {
+
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %s", tz)
+	}
+	return loc, nil
+}
+
+// SetGameTimes stores a game's start and, when not zero, end time under sync as RFC3339 UTC
+func (store *Store) SetGameTimes(gameId string, start time.Time, end time.Time) error {
+
+	if start.IsZero() {
+		return fmt.Errorf("a start time is required")
+	}
+	m := map[string]interface{}{"sync/start_time": FormatTime(start)}
+	if !end.IsZero() {
+		if !end.After(start) {
+			return fmt.Errorf("end time must be after the start time")
+		}
+		m["sync/end_time"] = FormatTime(end)
+	}
+	return store.UpdatePath(context.Background(), "games/"+gameId, m)
+}
+
+// SetPlayerTimezone stores a player's IANA timezone, used for the games they host
+func (store *Store) SetPlayerTimezone(playerId string, tz string) error {
+
+	if _, err := loadTimezone(tz); err != nil {
+		return err
+	}
+	return store.SetPath(context.Background(), "players/"+playerId+"/timezone", tz)
+}
+
+// GetPlayerTimezone returns a player's timezone, UTC when they never set one
+func (store *Store) GetPlayerTimezone(playerId string) (string, error) {
+
+	var tz string
+	if err := store.GetPath(context.Background(), "players/"+playerId+"/timezone", &tz); err != nil {
+		return "", err
+	}
+	if tz == "" {
+		return "UTC", nil
+	}
+	return tz, nil
+}
+
+// SetGameTimezone stores the timezone a game is played in, usually its host's
+func (store *Store) SetGameTimezone(gameId string, tz string) error {
+
+	if _, err := loadTimezone(tz); err != nil {
+		return err
+	}
+	return store.SetPath(context.Background(), "games/"+gameId+"/timezone", tz)
+}
+
+// GetGameTimezone returns a game's timezone, falling back to its creator's and then UTC
+func (store *Store) GetGameTimezone(gameId string) (string, error) {
+
+	ctx := context.Background()
+	var tz string
+	if err := store.GetPath(ctx, "games/"+gameId+"/timezone", &tz); err != nil {
+		return "", err
+	}
+	if tz != "" {
+		return tz, nil
+	}
+	var creator string
+	if err := store.GetPath(ctx, "games/"+gameId+"/creator/bin", &creator); err != nil {
+		return "", err
+	}
+	if creator == "" {
+		return "UTC", nil
+	}
+	return store.GetPlayerTimezone(creator)
+}
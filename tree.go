@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// GetShallowKeys returns the sorted keys of the node at path without downloading their children
+func (store *Store) GetShallowKeys(path string) ([]string, error) {
+
+	var m map[string]interface{}
+	if err := store.GetShallowPath(context.Background(), path, &m); err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// ExportTree writes the node at path (e.g. games/{id} or characters) to w as JSON, reading it from
+// the secondary when the store has one
+func (store *Store) ExportTree(path string, w io.Writer) error {
+
+	var v interface{}
+	if err := store.GetPath(OnSecondary(context.Background()), path, &v); err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("error exporting %s: %v", path, err)
+	}
+	return nil
+}
+
+// ImportTree reads JSON from r and replaces the node at path with it
+func (store *Store) ImportTree(path string, r io.Reader) error {
+
+	if strings.Trim(path, "/") == "" {
+		return fmt.Errorf("refusing to import over the database root")
+	}
+
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return fmt.Errorf("error importing %s: %v", path, err)
+	}
+	if v == nil {
+		return fmt.Errorf("nothing to import into %s", path)
+	}
+
+	return store.SetPath(context.Background(), path, v)
+}
@@ -0,0 +1,91 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+	"log"
+)
+
+// ErrInvalidType is returned when a record of the wrong type is passed for a data type
+var ErrInvalidType = errors.New("invalid type")
+
+func invalidType(v interface{}, dataType string) error {
+	return fmt.Errorf("%w: %T for %s", ErrInvalidType, v, dataType)
+}
+
+// the raw helpers read untyped database values without panicking on missing or mistyped fields
+
+func rawMap(v interface{}) map[string]interface{} {
+	m, _ := v.(map[string]interface{})
+	return m
+}
+
+// rawItems returns the children of a node read back as either an object or an array
+func rawItems(v interface{}) []interface{} {
+
+	switch t := v.(type) {
+	case []interface{}:
+		return t
+	case map[string]interface{}:
+		items := make([]interface{}, 0, len(t))
+		for _, item := range t {
+			items = append(items, item)
+		}
+		return items
+	}
+	return nil
+}
+
+func rawString(m map[string]interface{}, k string) string {
+	s, _ := m[k].(string)
+	return s
+}
+
+func rawBool(m map[string]interface{}, k string) bool {
+	b, _ := m[k].(bool)
+	return b
+}
+
+func rawInt(m map[string]interface{}, k string) int {
+	f, _ := m[k].(float64)
+	return int(f)
+}
+
+// rawDecode decodes the child k of m into v when it is set
+func rawDecode(m map[string]interface{}, k string, v interface{}) {
+	if m[k] == nil {
+		return
+	}
+	if err := decodeRaw(m[k], v); err != nil {
+		log.Printf("Error decoding %s: %v", k, err)
+	}
+}
+
+func parseRawPlayer(p map[string]interface{}) *models.Player {
+	return &models.Player{
+		Bin:      rawString(p, "bin"),
+		UserName: rawString(p, "user_name"),
+		Status:   rawString(p, "status"),
+		Photo:    rawString(p, "photo"),
+		Privacy:  rawString(p, "privacy"),
+	}
+}
+
+func parseRawStep(st map[string]interface{}) *models.Step {
+
+	step := &models.Step{
+		Bin:          rawString(st, "bin"),
+		StepType:     rawString(st, "step_type"),
+		Duration:     rawString(st, "duration"),
+		Command:      rawString(st, "command"),
+		StepIndex:    rawInt(st, "step_index"),
+		RequiresVote: rawBool(st, "requires_vote"),
+		VoteType:     rawString(st, "vote_type"),
+		NextStep:     rawString(st, "next_step"),
+	}
+	rawDecode(st, "characters", &step.Characters)
+	rawDecode(st, "sub_steps", &step.SubSteps)
+	rawDecode(st, "allowed", &step.Allowed)
+	return step
+}
@@ -0,0 +1,192 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+	"strings"
+)
+
+// ErrInvalidRecord matches every ValidationError with errors.Is
+var ErrInvalidRecord = errors.New("invalid record")
+
+// ValidationError lists every problem found in a record or update before it was written
+type ValidationError struct {
+	DataType string
+	Bin      string
+	Problems []error
+}
+
+func (e *ValidationError) Error() string {
+
+	msgs := make([]string, len(e.Problems))
+	for i, p := range e.Problems {
+		msgs[i] = p.Error()
+	}
+	return fmt.Sprintf("invalid %s %s: %s", e.DataType, e.Bin, strings.Join(msgs, "; "))
+}
+
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrInvalidRecord
+}
+
+func (e *ValidationError) Unwrap() []error {
+	return e.Problems
+}
+
+// ValidationRule checks a record of one data type and returns its problems
+type ValidationRule func(v interface{}) []error
+
+// WithValidationRule adds a rule run on every record of dataType created through the store
+func WithValidationRule(dataType string, rule ValidationRule) StoreOption {
+	return func(store *Store) {
+		if store.validators == nil {
+			store.validators = make(map[string][]ValidationRule)
+		}
+		store.validators[dataType] = append(store.validators[dataType], rule)
+	}
+}
+
+// valid statuses per data type, empty is allowed for records written before statuses existed
+var (
+	gameStatuses   = []string{"", "waiting", "started", "ended", GameOrphaned}
+	groupStatuses  = []string{"", "waiting", "started", "ended"}
+	playerStatuses = []string{"", "available", "inAGame", "afk"}
+	privacyLevels  = append([]string{""}, privacyValues...)
+)
+
+func oneOf(field string, v string, allowed []string) error {
+	if contains(allowed, v) {
+		return nil
+	}
+	return fmt.Errorf("invalid %s: %s", field, v)
+}
+
+func required(field string, v string) error {
+	if v == "" {
+		return fmt.Errorf("%s is required", field)
+	}
+	return nil
+}
+
+// recordRules are the built in checks of each data type's model
+var recordRules = map[string]ValidationRule{
+	"games": func(v interface{}) []error {
+		g := v.(*models.Game)
+		errs := []error{required("bin", g.Bin), oneOf("status", g.Status, gameStatuses)}
+		for bin, st := range g.Steps {
+			if st != nil {
+				errs = append(errs, stepProblems("steps/"+bin+"/", st)...)
+			}
+		}
+		for bin, gm := range g.Gamers {
+			if gm != nil && gm.Bin != bin {
+				errs = append(errs, fmt.Errorf("gamer %s is stored under %s", gm.Bin, bin))
+			}
+		}
+		return errs
+	},
+	"game_groups": func(v interface{}) []error {
+		g := v.(*models.Group)
+		errs := []error{required("bin", g.Bin), oneOf("status", g.Status, groupStatuses)}
+		if g.Capacity <= 0 {
+			errs = append(errs, fmt.Errorf("capacity must be greater than 0"))
+		}
+		return errs
+	},
+	"players": func(v interface{}) []error {
+		p := v.(*models.Player)
+		return []error{required("bin", p.Bin), oneOf("status", p.Status, playerStatuses), oneOf("privacy", p.Privacy, privacyLevels)}
+	},
+	"steps": func(v interface{}) []error {
+		return stepProblems("", v.(*models.Step))
+	},
+	"characters": func(v interface{}) []error {
+		return []error{required("bin", v.(*models.GameCharacter).Bin)}
+	},
+	"abilities": func(v interface{}) []error {
+		return []error{required("bin", v.(*models.Ability).Bin)}
+	},
+}
+
+func stepProblems(prefix string, st *models.Step) []error {
+
+	errs := []error{required(prefix+"bin", st.Bin)}
+	if st.StepIndex < 0 {
+		errs = append(errs, fmt.Errorf("%sstep_index must not be negative", prefix))
+	}
+	if st.RequiresVote && st.VoteType == "" {
+		errs = append(errs, fmt.Errorf("%svote_type is required for voting steps", prefix))
+	}
+	return errs
+}
+
+// fieldRules check the top level fields of updates, keyed by data type and field
+var fieldRules = map[string]map[string]func(v interface{}) error{
+	"games": {
+		"status": func(v interface{}) error { return oneOfRaw("status", v, gameStatuses) },
+	},
+	"game_groups": {
+		"status": func(v interface{}) error { return oneOfRaw("status", v, groupStatuses) },
+		"capacity": func(v interface{}) error {
+			if n, ok := v.(int); ok && n > 0 {
+				return nil
+			}
+			if f, ok := v.(float64); ok && f > 0 {
+				return nil
+			}
+			return fmt.Errorf("capacity must be greater than 0")
+		},
+	},
+	"players": {
+		"status":  func(v interface{}) error { return oneOfRaw("status", v, playerStatuses) },
+		"privacy": func(v interface{}) error { return oneOfRaw("privacy", v, privacyLevels) },
+	},
+}
+
+func oneOfRaw(field string, v interface{}, allowed []string) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid %s: %v", field, v)
+	}
+	return oneOf(field, s, allowed)
+}
+
+func validationError(dataType string, bin string, problems []error) error {
+
+	var found []error
+	for _, p := range problems {
+		if p != nil {
+			found = append(found, p)
+		}
+	}
+	if len(found) == 0 {
+		return nil
+	}
+	return &ValidationError{DataType: dataType, Bin: bin, Problems: found}
+}
+
+// validate runs the built in and configured rules of a data type on a record about to be created
+func (store *Store) validate(dataType string, bin string, v interface{}) error {
+
+	var problems []error
+	if rule, ok := recordRules[dataType]; ok {
+		problems = append(problems, rule(v)...)
+	}
+	for _, rule := range store.validators[dataType] {
+		problems = append(problems, rule(v)...)
+	}
+	return validationError(dataType, bin, problems)
+}
+
+// validateUpdate checks the known top level fields of an update, deletes are not checked
+func (store *Store) validateUpdate(dataType string, bin string, m map[string]interface{}) error {
+
+	var problems []error
+	for field, check := range fieldRules[dataType] {
+		if v, ok := m[field]; ok && v != nil {
+			problems = append(problems, check(v))
+		}
+	}
+	return validationError(dataType, bin, problems)
+}
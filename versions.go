@@ -0,0 +1,166 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrOutdatedVersion is returned for callers older than the minimum supported version
+var ErrOutdatedVersion = errors.New("version is no longer supported")
+
+// caller kinds with a minimum version
+const (
+	CallerClient     = "client"
+	CallerGameServer = "game_server"
+)
+
+// MinVersions are the oldest supported versions under min_versions, empty means any
+type MinVersions struct {
+	Client     string `json:"client,omitempty"`
+	GameServer string `json:"game_server,omitempty"`
+	UpdatedAt  string `json:"updated_at,omitempty"`
+}
+
+func (m *MinVersions) minFor(kind string) string {
+	switch kind {
+	case CallerClient:
+		return m.Client
+	case CallerGameServer:
+		return m.GameServer
+	}
+	return ""
+}
+
+// minVersionsTTL is how long a store trusts the minimum versions it last read
+const minVersionsTTL = 30 * time.Second
+
+type callerVersionKey struct{}
+
+type callerVersion struct {
+	kind    string
+	version string
+}
+
+// WithCallerVersion returns a context advertising the caller's kind and version. Writes made with it
+// are refused with ErrOutdatedVersion when the store was created WithVersionGate and the version is
+// too old. Like DryRun it applies to calls taking a context.
+func WithCallerVersion(ctx context.Context, kind string, version string) context.Context {
+	return context.WithValue(ctx, callerVersionKey{}, callerVersion{kind: kind, version: version})
+}
+
+// WithVersionGate makes the store check the version advertised on the context of every write
+func WithVersionGate() StoreOption {
+	return func(store *Store) {
+		store.versions.gate = true
+	}
+}
+
+type versionState struct {
+	mu      sync.Mutex
+	current MinVersions
+	checked time.Time
+	gate    bool
+}
+
+// parseVersion reads "v1.2.3-beta" as [1 2 3], missing or non numeric parts are 0
+func parseVersion(v string) []int {
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	var parts []int
+	for _, p := range strings.Split(v, ".") {
+		n, _ := strconv.Atoi(p)
+		parts = append(parts, n)
+	}
+	return parts
+}
+
+// CompareVersions returns -1, 0 or 1 as a is older than, the same as or newer than b
+func CompareVersions(a string, b string) int {
+	pa, pb := parseVersion(a), parseVersion(b)
+	for i := 0; i < len(pa) || i < len(pb); i++ {
+		var x, y int
+		if i < len(pa) {
+			x = pa[i]
+		}
+		if i < len(pb) {
+			y = pb[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// SetMinVersions stores the oldest supported client and game server versions
+func (store *Store) SetMinVersions(m MinVersions) error {
+
+	m.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	if err := store.SetPath(context.Background(), "min_versions", m); err != nil {
+		return err
+	}
+	store.versions.mu.Lock()
+	store.versions.current = m
+	store.versions.checked = time.Now()
+	store.versions.mu.Unlock()
+	return nil
+}
+
+// GetMinVersions returns the minimum versions, read again once the last read is a few seconds old
+func (store *Store) GetMinVersions() (MinVersions, error) {
+
+	s := &store.versions
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if time.Since(s.checked) < minVersionsTTL {
+		return s.current, nil
+	}
+	var m MinVersions
+	if err := store.GetPath(context.Background(), "min_versions", &m); err != nil {
+		return s.current, err
+	}
+	s.current = m
+	s.checked = time.Now()
+	return m, nil
+}
+
+// CheckVersion returns ErrOutdatedVersion when version is older than the minimum of the caller kind
+func (store *Store) CheckVersion(kind string, version string) error {
+
+	m, err := store.GetMinVersions()
+	if err != nil {
+		return err
+	}
+	min := m.minFor(kind)
+	if min != "" && CompareVersions(version, min) < 0 {
+		return fmt.Errorf("%w: %s %s, the minimum is %s", ErrOutdatedVersion, kind, version, min)
+	}
+	return nil
+}
+
+// checkCallerVersion enforces the version gate for a write made with ctx. Callers that advertise no
+// version, e.g. internal jobs, are let through.
+func (store *Store) checkCallerVersion(ctx context.Context, op string, path string) error {
+
+	if !store.versions.gate {
+		return nil
+	}
+	c, ok := ctx.Value(callerVersionKey{}).(callerVersion)
+	if !ok {
+		return nil
+	}
+	if err := store.CheckVersion(c.kind, c.version); errors.Is(err, ErrOutdatedVersion) {
+		return wrapErr(op, path, err)
+	}
+	return nil
+}
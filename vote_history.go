@@ -0,0 +1,81 @@
+package v1
+
+import (
+	"context"
+	models "github.com/horcu/pm-models/types"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// VoteRecord is one vote of a gamer's voting record
+type VoteRecord struct {
+	Cycle     int    `json:"cycle"`
+	StepBin   string `json:"step_bin"`
+	Voter     string `json:"voter"`
+	Target    string `json:"target"`
+	Ability   string `json:"ability,omitempty"`
+	TimeStamp string `json:"timestamp"`
+}
+
+// VoteHistory is every vote a gamer cast and every vote cast against them, oldest first
+type VoteHistory struct {
+	GameId   string        `json:"game_id"`
+	GamerId  string        `json:"gamer_id"`
+	Cast     []*VoteRecord `json:"cast"`
+	Received []*VoteRecord `json:"received"`
+}
+
+// GetVoteHistory returns the voting record of a gamer across every cycle of a game
+func (store *Store) GetVoteHistory(gameId string, gamerId string) (*VoteHistory, error) {
+
+	var cycles map[string]map[string]map[string][]*models.Result
+	if err := store.GetPath(context.Background(), "games/"+gameId+"/cycle_results", &cycles); err != nil {
+		return nil, err
+	}
+
+	h := &VoteHistory{GameId: gameId, GamerId: gamerId, Cast: []*VoteRecord{}, Received: []*VoteRecord{}}
+	for key, steps := range cycles {
+		cycle, _ := strconv.Atoi(strings.TrimPrefix(key, "c"))
+		for stepId, byGamer := range steps {
+			for voter, results := range byGamer {
+				for _, r := range results {
+					if r == nil || r.Vote.Target == "" {
+						continue
+					}
+					if voter != gamerId && r.Vote.Target != gamerId {
+						continue
+					}
+					rec := &VoteRecord{
+						Cycle:     cycle,
+						StepBin:   stepId,
+						Voter:     voter,
+						Target:    r.Vote.Target,
+						Ability:   r.Vote.Ability,
+						TimeStamp: r.TimeStamp,
+					}
+					if voter == gamerId {
+						h.Cast = append(h.Cast, rec)
+					}
+					if r.Vote.Target == gamerId {
+						h.Received = append(h.Received, rec)
+					}
+				}
+			}
+		}
+	}
+	sortVoteRecords(h.Cast)
+	sortVoteRecords(h.Received)
+	return h, nil
+}
+
+func sortVoteRecords(records []*VoteRecord) {
+	sort.SliceStable(records, func(i, j int) bool {
+		if records[i].Cycle != records[j].Cycle {
+			return records[i].Cycle < records[j].Cycle
+		}
+		a, _ := strconv.ParseInt(records[i].TimeStamp, 10, 64)
+		b, _ := strconv.ParseInt(records[j].TimeStamp, 10, 64)
+		return a < b
+	})
+}
@@ -0,0 +1,230 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	models "github.com/horcu/pm-models/types"
+	"log"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrFull is returned when a game or group has no free slot
+var ErrFull = errors.New("capacity reached")
+
+// ErrWaitlisted is returned instead of ErrFull when the player was put on the waiting list
+var ErrWaitlisted = errors.New("added to the waiting list")
+
+// NotifyWaitlistPromoted is the notification type sent to a waitlisted player who got a slot
+const NotifyWaitlistPromoted = "waitlist.promoted"
+
+// WaitlistEntry is a player waiting for a slot under games/{id}/waitlist/{playerId} or
+// game_groups/{id}/waitlist/{playerId}, served in JoinedAt order
+type WaitlistEntry struct {
+	PlayerId string `json:"player_id"`
+	JoinedAt int64  `json:"joined_at"` // unix milliseconds
+}
+
+// GetGameWaitlist returns the players waiting for a slot in a game, first in line first
+func (store *Store) GetGameWaitlist(gameId string) ([]*WaitlistEntry, error) {
+	return store.getWaitlist("games/" + gameId)
+}
+
+// GetGroupWaitlist returns the players waiting for a slot in a group, first in line first
+func (store *Store) GetGroupWaitlist(groupId string) ([]*WaitlistEntry, error) {
+	return store.getWaitlist("game_groups/" + groupId)
+}
+
+// LeaveGameWaitlist takes a player off a game's waiting list
+func (store *Store) LeaveGameWaitlist(gameId string, playerId string) error {
+	return store.DeletePath(context.Background(), "games/"+gameId+"/waitlist/"+playerId)
+}
+
+// LeaveGroupWaitlist takes a player off a group's waiting list
+func (store *Store) LeaveGroupWaitlist(groupId string, playerId string) error {
+	return store.DeletePath(context.Background(), "game_groups/"+groupId+"/waitlist/"+playerId)
+}
+
+// addToWaitlist queues a player behind the node's waiting list and returns its 1-based position,
+// a player already on the list keeps their place
+func (store *Store) addToWaitlist(parent string, playerId string) (int, error) {
+
+	ctx := context.Background()
+	entry := &WaitlistEntry{}
+	if err := store.GetPath(ctx, parent+"/waitlist/"+playerId, entry); err != nil {
+		return 0, err
+	}
+	if entry.PlayerId == "" {
+		entry = &WaitlistEntry{PlayerId: playerId, JoinedAt: time.Now().UnixMilli()}
+		if err := store.SetPath(ctx, parent+"/waitlist/"+playerId, entry); err != nil {
+			return 0, err
+		}
+	}
+
+	list, err := store.getWaitlist(parent)
+	if err != nil {
+		return 0, err
+	}
+	for i, e := range list {
+		if e.PlayerId == playerId {
+			return i + 1, nil
+		}
+	}
+	return len(list), nil
+}
+
+func (store *Store) getWaitlist(parent string) ([]*WaitlistEntry, error) {
+
+	var m map[string]*WaitlistEntry
+	if err := store.GetPath(context.Background(), parent+"/waitlist", &m); err != nil {
+		return nil, err
+	}
+	list := make([]*WaitlistEntry, 0, len(m))
+	for id, e := range m {
+		if e == nil {
+			continue
+		}
+		e.PlayerId = id
+		list = append(list, e)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].JoinedAt != list[j].JoinedAt {
+			return list[i].JoinedAt < list[j].JoinedAt
+		}
+		return list[i].PlayerId < list[j].PlayerId
+	})
+	return list, nil
+}
+
+// waitlistGamer puts a player who found a game full on its waiting list
+func (store *Store) waitlistGamer(gameId string, playerId string) error {
+
+	pos, err := store.addToWaitlist("games/"+gameId, playerId)
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: game %s, position %d", ErrWaitlisted, gameId, pos)
+}
+
+// waitlistMember puts a player who found a group full on its waiting list
+func (store *Store) waitlistMember(groupId string, playerId string) error {
+
+	pos, err := store.addToWaitlist("game_groups/"+groupId, playerId)
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: group %s, position %d", ErrWaitlisted, groupId, pos)
+}
+
+// groupIsFull reports whether a group has reached its capacity
+func (store *Store) groupIsFull(groupId string) (bool, error) {
+
+	var capacity int
+	if err := store.GetPath(context.Background(), "game_groups/"+groupId+"/capacity", &capacity); err != nil {
+		return false, err
+	}
+	if capacity <= 0 {
+		return false, nil
+	}
+	n, err := store.CountGroupMembers(groupId)
+	if err != nil {
+		return false, err
+	}
+	return n >= capacity, nil
+}
+
+// promoteGameWaitlist hands the freed slots of a game to the waitlisted players who can still
+// join, in line order, dropping the ones who can't. It is called by every path that removes gamers.
+func (store *Store) promoteGameWaitlist(gameId string) {
+
+	list, err := store.GetGameWaitlist(gameId)
+	if err != nil {
+		log.Printf("Error reading the waiting list of game %s: %v", gameId, err)
+		return
+	}
+	for _, e := range list {
+		_, joinErr := store.JoinGame(e.PlayerId, gameId)
+		if errors.Is(joinErr, ErrWaitlisted) {
+			// the game is full again, keep the rest of the line waiting
+			return
+		}
+		if err := store.LeaveGameWaitlist(gameId, e.PlayerId); err != nil {
+			log.Printf("Error updating the waiting list of game %s: %v", gameId, err)
+			return
+		}
+		if joinErr != nil {
+			log.Printf("Error promoting player %s into game %s: %v", e.PlayerId, gameId, joinErr)
+			continue
+		}
+		store.notifyPromoted(e.PlayerId, &Notification{GameId: gameId, Title: "A spot opened up in your game"})
+	}
+}
+
+// removesGamers reports whether an update of a game's gamers node, or of the game itself when
+// prefix is "gamers/", deletes a gamer
+func removesGamers(m map[string]interface{}, prefix string) bool {
+	for k, v := range m {
+		k = strings.Trim(k, "/")
+		if v == nil && strings.HasPrefix(k, prefix) && !strings.Contains(strings.TrimPrefix(k, prefix), "/") {
+			return true
+		}
+	}
+	return false
+}
+
+// promoteGroupWaitlist hands a freed group slot to the first waitlisted player
+func (store *Store) promoteGroupWaitlist(groupId string) {
+
+	list, err := store.GetGroupWaitlist(groupId)
+	if err != nil {
+		log.Printf("Error reading the waiting list of group %s: %v", groupId, err)
+		return
+	}
+	ctx := context.Background()
+	for _, e := range list {
+		full, err := store.groupIsFull(groupId)
+		if err != nil || full {
+			return
+		}
+
+		p := &models.Player{}
+		err = store.GetPath(ctx, "players/"+e.PlayerId, p)
+		if err == nil && p.Bin == "" {
+			err = fmt.Errorf("player %s not found", e.PlayerId)
+		}
+		if err == nil {
+			var members []string
+			members, err = store.GetShallowKeys("game_groups/" + groupId + "/members")
+			if err == nil {
+				err = store.checkNotBlocked(e.PlayerId, members)
+			}
+		}
+		if err != nil {
+			log.Printf("Error promoting player %s into group %s: %v", e.PlayerId, groupId, err)
+			_ = store.LeaveGroupWaitlist(groupId, e.PlayerId)
+			continue
+		}
+
+		err = store.UpdateGameGroup(groupId, map[string]interface{}{
+			"members/" + p.Bin:  memberNode(p, RoleMember),
+			"waitlist/" + p.Bin: nil,
+		})
+		if err != nil {
+			log.Printf("Error promoting player %s into group %s: %v", e.PlayerId, groupId, err)
+			return
+		}
+		store.addPlayerRef(p.Bin, "groups", groupId)
+		store.notifyPromoted(p.Bin, &Notification{GroupId: groupId, Title: "A spot opened up in your group"})
+		return
+	}
+}
+
+func (store *Store) notifyPromoted(playerId string, n *Notification) {
+
+	n.Type = NotifyWaitlistPromoted
+	if err := store.CreateNotification(playerId, n); err != nil {
+		log.Printf("Error notifying player %s of %s: %v", playerId, n.Type, err)
+	}
+}
@@ -0,0 +1,244 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	models "github.com/horcu/pm-models/types"
+	"log"
+	"reflect"
+	"time"
+)
+
+// The admin database client has no streaming listeners, so watchers poll with ETags: an
+// unchanged node costs a round trip without a download. Every poll is diffed against the last
+// snapshot, so changes made while the database was unreachable are still emitted on recovery.
+
+// defaultWatchInterval is how often watchers poll unless WithWatchInterval says otherwise
+const defaultWatchInterval = 2 * time.Second
+
+// maxWatchBackoff caps the wait between polls while the database is failing
+const maxWatchBackoff = 30 * time.Second
+
+// watch change kinds
+const (
+	WatchAdded   = "added"
+	WatchChanged = "changed"
+	WatchRemoved = "removed"
+)
+
+// WithWatchInterval sets how often watchers poll the database
+func WithWatchInterval(interval time.Duration) StoreOption {
+	return func(store *Store) {
+		store.watchInterval = interval
+	}
+}
+
+// pollNode calls onChange with the value at path whenever it changes, starting with the current
+// value, until ctx is done. When onChange returns false the same value is delivered again on the
+// next poll.
+func (store *Store) pollNode(ctx context.Context, path string, onChange func(raw interface{}) bool) {
+
+	interval := store.watchInterval
+	if interval <= 0 {
+		interval = defaultWatchInterval
+	}
+
+	etag := ""
+	wait := interval
+	for {
+		var raw interface{}
+		opCtx, cancel := store.opContext(ctx, false)
+		changed, next, err := store.NewRef(path).GetIfChanged(opCtx, etag, &raw)
+		cancel()
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			log.Printf("Error watching %s, retrying in %v: %v", path, wait, err)
+			wait *= 2
+			if wait > maxWatchBackoff {
+				wait = maxWatchBackoff
+			}
+		default:
+			wait = interval
+			if (changed || etag == "") && onChange(raw) {
+				etag = next
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// watchChildren calls emit for every child of path added, changed or removed between polls.
+// The first poll reports every existing child as added.
+func (store *Store) watchChildren(ctx context.Context, path string, emit func(kind string, key string, raw interface{})) {
+
+	var prev map[string]interface{}
+	store.pollNode(ctx, path, func(raw interface{}) bool {
+		next, _ := raw.(map[string]interface{})
+		for k, v := range next {
+			old, ok := prev[k]
+			if !ok {
+				emit(WatchAdded, k, v)
+			} else if !reflect.DeepEqual(old, v) {
+				emit(WatchChanged, k, v)
+			}
+		}
+		for k, v := range prev {
+			if _, ok := next[k]; !ok {
+				emit(WatchRemoved, k, v)
+			}
+		}
+		prev = next
+		return true
+	})
+}
+
+// decodeRaw converts a raw database value into v
+func decodeRaw(raw interface{}, v interface{}) error {
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// InvitationEvent is an invitation of a player being added, changed or removed
+type InvitationEvent struct {
+	Kind       string
+	Bin        string
+	Invitation *models.Invitation // last known value for removals
+}
+
+// WatchPlayerInvitations streams changes to a player's invitations, starting with the existing
+// ones as added, until ctx is done. The channel is closed when the watch stops.
+func (store *Store) WatchPlayerInvitations(ctx context.Context, playerId string) <-chan *InvitationEvent {
+
+	ch := make(chan *InvitationEvent, 16)
+	go func() {
+		defer close(ch)
+		store.watchChildren(ctx, "players/"+playerId+"/invitations", func(kind string, key string, raw interface{}) {
+			inv := &models.Invitation{}
+			if err := decodeRaw(raw, inv); err != nil {
+				log.Printf("Error decoding invitation %s of player %s: %v", key, playerId, err)
+				return
+			}
+			select {
+			case ch <- &InvitationEvent{Kind: kind, Bin: key, Invitation: inv}:
+			case <-ctx.Done():
+			}
+		})
+	}()
+	return ch
+}
+
+// group membership event kinds
+const (
+	MemberJoined = "joined"
+	MemberLeft   = "left"
+)
+
+// MemberEvent is a player joining or leaving a group
+type MemberEvent struct {
+	Kind     string
+	GroupId  string
+	PlayerId string
+	Player   *models.Player // last known value for leaves
+}
+
+// WatchGroupMembers streams joins and leaves of a group, starting with the current members as
+// joined, until ctx is done. The channel is closed when the watch stops.
+func (store *Store) WatchGroupMembers(ctx context.Context, groupId string) <-chan *MemberEvent {
+
+	ch := make(chan *MemberEvent, 16)
+	go func() {
+		defer close(ch)
+		store.watchChildren(ctx, "game_groups/"+groupId+"/members", func(kind string, key string, raw interface{}) {
+			e := &MemberEvent{GroupId: groupId, PlayerId: key, Player: &models.Player{}}
+			switch kind {
+			case WatchAdded:
+				e.Kind = MemberJoined
+			case WatchRemoved:
+				e.Kind = MemberLeft
+			default:
+				return
+			}
+			if err := decodeRaw(raw, e.Player); err != nil {
+				log.Printf("Error decoding member %s of group %s: %v", key, groupId, err)
+				return
+			}
+			select {
+			case ch <- e:
+			case <-ctx.Done():
+			}
+		})
+	}()
+	return ch
+}
+
+// WatchCurrentStep streams the resolved step of a game every time its current_step pointer
+// moves, starting with the current one, until ctx is done. A step is only emitted once its
+// document can be read and the pointer still names it, so the game loop never sees a pointer
+// without its step data. The channel is closed when the watch stops.
+func (store *Store) WatchCurrentStep(ctx context.Context, gameId string) <-chan *models.Step {
+
+	ch := make(chan *models.Step, 1)
+	go func() {
+		defer close(ch)
+		emitted := ""
+		store.pollNode(ctx, "games/"+gameId+"/current_step", func(raw interface{}) bool {
+			bin, _ := raw.(string)
+			if bin == "" || bin == emitted {
+				return true
+			}
+			step, err := store.resolveGameStep(ctx, gameId, bin)
+			if err != nil {
+				log.Printf("Error resolving step %s of game %s: %v", bin, gameId, err)
+				return false
+			}
+			if step == nil {
+				// not written yet, or already replaced by the next pointer
+				return false
+			}
+			emitted = bin
+			select {
+			case ch <- step:
+			case <-ctx.Done():
+			}
+			return true
+		})
+	}()
+	return ch
+}
+
+// resolveGameStep reads a step from the game, falling back to the shared steps, and returns nil
+// when it isn't written yet or the pointer moved on meanwhile
+func (store *Store) resolveGameStep(ctx context.Context, gameId string, bin string) (*models.Step, error) {
+
+	step := &models.Step{}
+	if err := store.GetPath(ctx, "games/"+gameId+"/steps/"+bin, step); err != nil {
+		return nil, err
+	}
+	if step.Bin == "" {
+		if err := store.GetPath(ctx, "steps/"+bin, step); err != nil {
+			return nil, err
+		}
+	}
+	if step.Bin == "" {
+		return nil, nil
+	}
+
+	var current string
+	if err := store.GetPath(ctx, "games/"+gameId+"/current_step", &current); err != nil {
+		return nil, err
+	}
+	if current != bin {
+		return nil, nil
+	}
+	return step, nil
+}
@@ -0,0 +1,189 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"log"
+	"net/http"
+	"time"
+)
+
+// Webhook is an outbound endpoint registered under webhooks/{bin}
+type Webhook struct {
+	Bin       string   `json:"bin"`
+	URL       string   `json:"url"`
+	Secret    string   `json:"secret"`
+	Events    []string `json:"events,omitempty"` // event types to deliver, empty means all
+	Active    bool     `json:"active"`
+	CreatedAt string   `json:"created_at"`
+}
+
+// WebhookDelivery is the log entry of one delivery, stored under webhook_deliveries/{hookBin}
+type WebhookDelivery struct {
+	Bin        string `json:"bin"`
+	EventBin   string `json:"event_bin"`
+	EventType  string `json:"event_type"`
+	Attempts   int    `json:"attempts"`
+	StatusCode int    `json:"status_code,omitempty"`
+	Error      string `json:"error,omitempty"`
+	Delivered  bool   `json:"delivered"`
+	TimeStamp  string `json:"timestamp"`
+}
+
+// webhook signature headers
+const (
+	WebhookSignatureHeader = "X-PM-Signature" // sha256=<hex hmac of the body keyed with the webhook secret>
+	WebhookEventHeader     = "X-PM-Event"
+)
+
+func (w *Webhook) wants(eventType string) bool {
+	return w.Active && (len(w.Events) == 0 || contains(w.Events, eventType))
+}
+
+// RegisterWebhook stores a webhook, assigning it a bin when it has none
+func (store *Store) RegisterWebhook(w *Webhook) error {
+
+	if w == nil || w.URL == "" || w.Secret == "" {
+		return fmt.Errorf("invalid webhook object")
+	}
+	if w.Bin == "" {
+		w.Bin = uuid.New().String()
+	}
+	if w.CreatedAt == "" {
+		w.CreatedAt = time.Now().UTC().Format(time.RFC3339)
+	}
+	return store.SetPath(context.Background(), "webhooks/"+w.Bin, w)
+}
+
+// DeleteWebhook removes a webhook and its delivery log
+func (store *Store) DeleteWebhook(bin string) error {
+
+	return store.UpdatePath(context.Background(), "/", map[string]interface{}{
+		"webhooks/" + bin:           nil,
+		"webhook_deliveries/" + bin: nil,
+	})
+}
+
+// GetWebhooks returns every registered webhook
+func (store *Store) GetWebhooks() (map[string]*Webhook, error) {
+
+	var m map[string]*Webhook
+	if err := store.GetPath(context.Background(), "webhooks", &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SignWebhookPayload returns the signature header value for a payload
+func SignWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookDispatcher is an EventPublisher posting game lifecycle events to registered webhooks
+type WebhookDispatcher struct {
+	store       *Store
+	client      *http.Client
+	events      []string
+	MaxAttempts int
+	Backoff     time.Duration // wait before the first retry, doubled on every further one
+}
+
+// NewWebhookDispatcher returns a dispatcher delivering the lifecycle events webhooks can subscribe to
+func NewWebhookDispatcher(store *Store) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		store:       store,
+		client:      &http.Client{Timeout: 10 * time.Second},
+		events:      []string{EventGameStarted, EventGameEnded, EventStepAdvanced, EventPlayerReported},
+		MaxAttempts: 5,
+		Backoff:     time.Second,
+	}
+}
+
+// Publish delivers the event to every interested webhook in the background
+func (d *WebhookDispatcher) Publish(ctx context.Context, e *DomainEvent) error {
+
+	if !contains(d.events, e.Type) {
+		return nil
+	}
+	hooks, err := d.store.GetWebhooks()
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	for _, w := range hooks {
+		if w != nil && w.wants(e.Type) {
+			go d.deliver(w, e, body)
+		}
+	}
+	return nil
+}
+
+func (d *WebhookDispatcher) deliver(w *Webhook, e *DomainEvent, body []byte) {
+
+	entry := &WebhookDelivery{
+		Bin:       uuid.New().String(),
+		EventBin:  e.Bin,
+		EventType: e.Type,
+	}
+
+	wait := d.Backoff
+	for entry.Attempts < d.MaxAttempts {
+		entry.Attempts++
+		entry.StatusCode, entry.Error = 0, ""
+
+		code, err := d.post(w, e, body)
+		entry.StatusCode = code
+		if err == nil && code >= 200 && code < 300 {
+			entry.Delivered = true
+			break
+		}
+		if err != nil {
+			entry.Error = err.Error()
+		} else {
+			entry.Error = fmt.Sprintf("unexpected status %d", code)
+		}
+		// client errors other than rate limiting will not get better with retries
+		if code >= 400 && code < 500 && code != http.StatusTooManyRequests {
+			break
+		}
+		if entry.Attempts < d.MaxAttempts {
+			time.Sleep(wait)
+			wait *= 2
+		}
+	}
+
+	entry.TimeStamp = time.Now().UTC().Format(time.RFC3339)
+	if err := d.store.SetPath(context.Background(), "webhook_deliveries/"+w.Bin+"/"+entry.Bin, entry); err != nil {
+		log.Printf("Error logging webhook delivery: %v", err)
+	}
+}
+
+func (d *WebhookDispatcher) post(w *Webhook, e *DomainEvent, body []byte) (int, error) {
+
+	req, err := http.NewRequest(http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, e.Type)
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(w.Secret, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode, nil
+}